@@ -0,0 +1,147 @@
+package collection
+
+import "sync"
+
+// AccumulatorMap represents a map of numeric counters and gauges that are
+// updated in place by Add, Max, and Min, so concurrent updates to the same key
+// need no separate Get and Put. A missing key is treated as zero by Add and as
+// absent by Max and Min. A bounded map saturates its values at its bounds,
+// including when integer arithmetic would overflow. The map is safe for
+// concurrent use, and each operation is atomic. The zero value is not usable;
+// use NewAccumulatorMap or NewBoundedAccumulatorMap instead.
+type AccumulatorMap[Key comparable, N Number] struct {
+	mutex    sync.Mutex
+	elements Map[Key, N]
+	bounded  bool
+	lower    N
+	upper    N
+}
+
+// NewAccumulatorMap returns an empty map with unbounded values.
+func NewAccumulatorMap[Key comparable, N Number]() (collection *AccumulatorMap[Key, N]) {
+	var zero N
+	return &AccumulatorMap[Key, N]{
+		mutex:    sync.Mutex{},
+		elements: make(Map[Key, N]),
+		bounded:  false,
+		lower:    zero,
+		upper:    zero,
+	}
+}
+
+// NewBoundedAccumulatorMap returns an empty map whose values saturate at the
+// specified lower and upper bounds. The bounds are swapped if the lower bound
+// is greater than the upper bound.
+func NewBoundedAccumulatorMap[Key comparable, N Number](lower N, upper N) (collection *AccumulatorMap[Key, N]) {
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	return &AccumulatorMap[Key, N]{
+		mutex:    sync.Mutex{},
+		elements: make(Map[Key, N]),
+		bounded:  true,
+		lower:    lower,
+		upper:    upper,
+	}
+}
+
+// Add adds the specified delta to the value of the specified key, returning
+// the new value.
+func (collection *AccumulatorMap[Key, N]) Add(key Key, delta N) (current N) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	previous := collection.elements[key]
+	current = previous + delta
+	if collection.bounded {
+		var zero N
+		if delta > zero && current < previous {
+			current = collection.upper
+		} else if delta < zero && current > previous {
+			current = collection.lower
+		}
+		current = collection.clamp(current)
+	}
+	collection.elements[key] = current
+	return current
+}
+
+// Clear removes all of the keys from the map.
+func (collection *AccumulatorMap[Key, N]) Clear() (modified bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return collection.elements.Clear()
+}
+
+// Get returns the value of the specified key and true, or zero and false if
+// the map does not contain the key.
+func (collection *AccumulatorMap[Key, N]) Get(key Key) (current N, exists bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	current, exists = collection.elements[key]
+	return current, exists
+}
+
+// Map returns a map containing a snapshot of all of the keys and values in the
+// map.
+func (collection *AccumulatorMap[Key, N]) Map() (elements map[Key]N) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return collection.elements.Map()
+}
+
+// Max replaces the value of the specified key with the specified candidate if
+// the map does not contain the key or the candidate is greater, returning the
+// new value.
+func (collection *AccumulatorMap[Key, N]) Max(key Key, candidate N) (current N) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	current, exists := collection.elements[key]
+	if candidate = collection.clamp(candidate); !exists || candidate > current {
+		current = candidate
+		collection.elements[key] = current
+	}
+	return current
+}
+
+// Min replaces the value of the specified key with the specified candidate if
+// the map does not contain the key or the candidate is less, returning the new
+// value.
+func (collection *AccumulatorMap[Key, N]) Min(key Key, candidate N) (current N) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	current, exists := collection.elements[key]
+	if candidate = collection.clamp(candidate); !exists || candidate < current {
+		current = candidate
+		collection.elements[key] = current
+	}
+	return current
+}
+
+// Remove removes the specified key from the map, returning its previous value
+// and true, or zero and false if the map did not contain the key.
+func (collection *AccumulatorMap[Key, N]) Remove(key Key) (previous N, exists bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	previous, exists = collection.elements[key]
+	delete(collection.elements, key)
+	return previous, exists
+}
+
+// Size returns the number of keys in the map.
+func (collection *AccumulatorMap[Key, N]) Size() (size int) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return len(collection.elements)
+}
+
+// clamp returns the specified value limited to the bounds of the map, if any.
+func (collection *AccumulatorMap[Key, N]) clamp(value N) (clamped N) {
+	if !collection.bounded {
+		return value
+	} else if value < collection.lower {
+		return collection.lower
+	} else if value > collection.upper {
+		return collection.upper
+	}
+	return value
+}
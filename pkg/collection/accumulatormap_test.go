@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccumulatorMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	require.Equal(test, 0, collection.Size())
+	require.Equal(test, math.MaxInt, collection.Add("a", math.MaxInt))
+	require.Equal(test, math.MinInt, collection.Add("a", 1))
+}
+
+func TestNewBoundedAccumulatorMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedAccumulatorMap[string](10, -10)
+	require.Equal(test, 10, collection.Add("a", 15))
+	require.Equal(test, -10, collection.Add("a", -30))
+	unsigned := NewBoundedAccumulatorMap[string, uint8](0, math.MaxUint8)
+	require.Equal(test, uint8(200), unsigned.Add("a", 200))
+	require.Equal(test, uint8(math.MaxUint8), unsigned.Add("a", 100))
+	signed := NewBoundedAccumulatorMap[string, int8](math.MinInt8, math.MaxInt8)
+	require.Equal(test, int8(-100), signed.Add("a", -100))
+	require.Equal(test, int8(math.MinInt8), signed.Add("a", -100))
+	require.Equal(test, int8(0), signed.Add("b", 0))
+}
+
+func TestAccumulatorMap_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	var group sync.WaitGroup
+	for index := 0; index < 8; index++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for jndex := 0; jndex < 100; jndex++ {
+				collection.Add("a", 1)
+			}
+		}()
+	}
+	group.Wait()
+	current, exists := collection.Get("a")
+	require.True(test, exists)
+	require.Equal(test, 800, current)
+	require.Equal(test, 799, collection.Add("a", -1))
+}
+
+func TestAccumulatorMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, float64]()
+	require.False(test, collection.Clear())
+	collection.Add("a", 1.5)
+	require.True(test, collection.Clear())
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestAccumulatorMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	_, exists := collection.Get("a")
+	require.False(test, exists)
+	collection.Add("a", 0)
+	_, exists = collection.Get("a")
+	require.True(test, exists)
+}
+
+func TestAccumulatorMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	collection.Add("a", 1)
+	collection.Add("b", 2)
+	elements := collection.Map()
+	require.Equal(test, map[string]int{"a": 1, "b": 2}, elements)
+	elements["a"] = 3
+	current, _ := collection.Get("a")
+	require.Equal(test, 1, current)
+}
+
+func TestAccumulatorMap_Max(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	require.Equal(test, -5, collection.Max("a", -5))
+	require.Equal(test, -1, collection.Max("a", -1))
+	require.Equal(test, -1, collection.Max("a", -3))
+	bounded := NewBoundedAccumulatorMap[string](0, 10)
+	require.Equal(test, 10, bounded.Max("a", 20))
+}
+
+func TestAccumulatorMap_Min(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, float64]()
+	require.Equal(test, 5.0, collection.Min("a", 5))
+	require.Equal(test, 1.0, collection.Min("a", 1))
+	require.Equal(test, 1.0, collection.Min("a", 3))
+	bounded := NewBoundedAccumulatorMap[string](0.0, 10.0)
+	require.Equal(test, 0.0, bounded.Min("a", -20))
+}
+
+func TestAccumulatorMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	collection.Add("a", 3)
+	previous, exists := collection.Remove("a")
+	require.True(test, exists)
+	require.Equal(test, 3, previous)
+	_, exists = collection.Remove("a")
+	require.False(test, exists)
+}
+
+func TestAccumulatorMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewAccumulatorMap[string, int]()
+	collection.Add("a", 1)
+	collection.Max("b", 1)
+	collection.Min("a", 0)
+	require.Equal(test, 2, collection.Size())
+}
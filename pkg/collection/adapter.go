@@ -0,0 +1,60 @@
+package collection
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// listAdapter adapts a list to heap.Interface and sort.Interface.
+type listAdapter[Value any] struct {
+	collection *List[Value]
+	comparator func(this Value, that Value) (less bool)
+}
+
+// AsHeap returns a heap.Interface backed by the specified list, ordered by the
+// specified comparator. The list is modified in place by the heap package, and
+// must be initialized with heap.Init unless it already satisfies the heap
+// invariants.
+func AsHeap[Value any](
+	collection *List[Value], comparator func(this Value, that Value) (less bool),
+) (adapter heap.Interface) {
+	return &listAdapter[Value]{collection: collection, comparator: comparator}
+}
+
+// AsSortInterface returns a sort.Interface backed by the specified list,
+// ordered by the specified comparator. The list is sorted in place by the sort
+// package.
+func AsSortInterface[Value any](
+	collection List[Value], comparator func(this Value, that Value) (less bool),
+) (adapter sort.Interface) {
+	return &listAdapter[Value]{collection: &collection, comparator: comparator}
+}
+
+// Len returns the number of values in the list.
+func (adapter *listAdapter[Value]) Len() (size int) {
+	return len(*adapter.collection)
+}
+
+// Less returns true if the value at the first position is ordered before the
+// value at the second position.
+func (adapter *listAdapter[Value]) Less(index int, jndex int) (less bool) {
+	return adapter.comparator((*adapter.collection)[index], (*adapter.collection)[jndex])
+}
+
+// Pop removes and returns the last value of the list.
+func (adapter *listAdapter[Value]) Pop() (value any) {
+	previous, _ := adapter.collection.Delete(len(*adapter.collection) - 1)
+	return previous
+}
+
+// Push adds the specified value to the end of the list.
+func (adapter *listAdapter[Value]) Push(value any) {
+	current, _ := value.(Value)
+	adapter.collection.Add(current)
+}
+
+// Swap swaps the values at the specified positions.
+func (adapter *listAdapter[Value]) Swap(index int, jndex int) {
+	values := *adapter.collection
+	values[index], values[jndex] = values[jndex], values[index]
+}
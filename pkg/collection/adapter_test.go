@@ -0,0 +1,36 @@
+package collection
+
+import (
+	"container/heap"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsHeap(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(3, 1, 2)
+	adapter := AsHeap(&collection, func(this int, that int) bool { return this < that })
+	heap.Init(adapter)
+	heap.Push(adapter, 0)
+	require.Equal(test, 4, collection.Size())
+
+	values := make([]int, 0)
+	for adapter.Len() > 0 {
+		values = append(values, heap.Pop(adapter).(int))
+	}
+	require.Equal(test, []int{0, 1, 2, 3}, values)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestAsSortInterface(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(3, 1, 2)
+	adapter := AsSortInterface(collection, func(this int, that int) bool { return this > that })
+	sort.Stable(adapter)
+	require.True(test, collection.Equal(3, 2, 1))
+	require.True(test, sort.IsSorted(adapter))
+}
@@ -0,0 +1,185 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// binaryVersion is the version of the binary encoding format.
+const binaryVersion byte = 1
+
+var (
+	// ErrOverflow indicates that a decoded value does not fit in its type.
+	ErrOverflow = errors.New("value overflows type")
+	// ErrUnsupportedType indicates that a type cannot be encoded.
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrUnsupportedVersion indicates that an encoding version is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported version")
+)
+
+// appendBinaryHeader appends the version byte and the specified number of
+// elements to the buffer.
+func appendBinaryHeader(buffer []byte, size int) (data []byte) {
+	buffer = append(buffer, binaryVersion)
+	return binary.AppendUvarint(buffer, uint64(size))
+}
+
+// appendBinary appends the binary encoding of the specified value to the
+// buffer. Strings are length-prefixed, integers are variable-length, and other
+// fixed-size types are encoded in little-endian byte order.
+func appendBinary(buffer []byte, value reflect.Value) (data []byte, err error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			return append(buffer, 1), nil
+		}
+		return append(buffer, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(buffer, value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(buffer, value.Uint()), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(buffer, math.Float32bits(float32(value.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(buffer, math.Float64bits(value.Float())), nil
+	case reflect.String:
+		buffer = binary.AppendUvarint(buffer, uint64(value.Len()))
+		return append(buffer, value.String()...), nil
+	case reflect.Interface, reflect.Pointer:
+		return buffer, fmt.Errorf("%w: %v", ErrUnsupportedType, value.Type())
+	default:
+		if binary.Size(value.Interface()) < 0 || unexportedBinary(value.Type()) {
+			return buffer, fmt.Errorf("%w: %v", ErrUnsupportedType, value.Type())
+		}
+		writer := bytes.NewBuffer(buffer)
+		err = binary.Write(writer, binary.LittleEndian, value.Interface())
+		return writer.Bytes(), err
+	}
+}
+
+// readBinaryHeader reads the version byte and the number of elements from the
+// reader. If every one of the specified element types has an empty encoding,
+// empty is true and the number of elements is not limited by the remaining
+// input; otherwise, each element needs at least one byte, so a number larger
+// than the remaining input is rejected.
+func readBinaryHeader(reader *bytes.Reader, types ...reflect.Type) (size int, empty bool, err error) {
+	version, err := reader.ReadByte()
+	if err != nil {
+		return 0, false, io.ErrUnexpectedEOF
+	} else if version != binaryVersion {
+		return 0, false, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	length, err := binary.ReadUvarint(reader)
+	if err != nil || length > math.MaxInt {
+		return 0, false, io.ErrUnexpectedEOF
+	}
+	empty = len(types) > 0
+	for _, current := range types {
+		empty = empty && emptyBinary(current)
+	}
+	if !empty && length > uint64(reader.Len()) {
+		return 0, false, io.ErrUnexpectedEOF
+	}
+	return int(length), empty, nil
+}
+
+// readBinary reads the binary encoding of a value from the reader into the
+// specified value, which must be settable.
+func readBinary(reader *bytes.Reader, value reflect.Value) (err error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		var current byte
+		current, err = reader.ReadByte()
+		value.SetBool(current != 0)
+		return unexpectedEOF(err)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var current int64
+		if current, err = binary.ReadVarint(reader); err != nil {
+			return unexpectedEOF(err)
+		} else if value.OverflowInt(current) {
+			return fmt.Errorf("%w: %d overflows %v", ErrOverflow, current, value.Type())
+		}
+		value.SetInt(current)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var current uint64
+		if current, err = binary.ReadUvarint(reader); err != nil {
+			return unexpectedEOF(err)
+		} else if value.OverflowUint(current) {
+			return fmt.Errorf("%w: %d overflows %v", ErrOverflow, current, value.Type())
+		}
+		value.SetUint(current)
+		return nil
+	case reflect.Float32:
+		var current uint32
+		err = binary.Read(reader, binary.LittleEndian, &current)
+		value.SetFloat(float64(math.Float32frombits(current)))
+		return unexpectedEOF(err)
+	case reflect.Float64:
+		var current uint64
+		err = binary.Read(reader, binary.LittleEndian, &current)
+		value.SetFloat(math.Float64frombits(current))
+		return unexpectedEOF(err)
+	case reflect.String:
+		var length uint64
+		if length, err = binary.ReadUvarint(reader); err != nil || length > uint64(reader.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		current := make([]byte, length)
+		_, err = io.ReadFull(reader, current)
+		value.SetString(string(current))
+		return unexpectedEOF(err)
+	case reflect.Interface, reflect.Pointer:
+		return fmt.Errorf("%w: %v", ErrUnsupportedType, value.Type())
+	default:
+		if binary.Size(value.Interface()) < 0 || unexportedBinary(value.Type()) {
+			return fmt.Errorf("%w: %v", ErrUnsupportedType, value.Type())
+		}
+		return unexpectedEOF(binary.Read(reader, binary.LittleEndian, value.Addr().Interface()))
+	}
+}
+
+// emptyBinary returns true if every value of the specified type has an empty
+// binary encoding, such as an empty struct.
+func emptyBinary(kind reflect.Type) (empty bool) {
+	switch kind.Kind() {
+	case reflect.Array, reflect.Struct:
+		return binary.Size(reflect.Zero(kind).Interface()) == 0
+	default:
+		return false
+	}
+}
+
+// unexportedBinary returns true if the specified type is a struct, or an array
+// of structs, with an unexported field at any depth, which encoding/binary
+// cannot set. Blank fields are skipped by encoding/binary, so they are allowed.
+func unexportedBinary(kind reflect.Type) (unexported bool) {
+	switch kind.Kind() {
+	case reflect.Array:
+		return unexportedBinary(kind.Elem())
+	case reflect.Struct:
+		for index := 0; index < kind.NumField(); index++ {
+			field := kind.Field(index)
+			if (!field.IsExported() && field.Name != "_") || unexportedBinary(field.Type) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// unexpectedEOF converts the end of input into an unexpected end of input,
+// since a complete encoding never ends in the middle of a value.
+func unexpectedEOF(err error) (converted error) {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
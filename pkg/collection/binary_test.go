@@ -0,0 +1,92 @@
+package collection
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendBinary(test *testing.T) {
+	test.Parallel()
+
+	type point struct {
+		X, Y int32
+		_    int16
+	}
+	data, err := List[[2]point]{{{X: 1, Y: 2}, {X: 3, Y: 4}}}.MarshalBinary()
+	require.NoError(test, err)
+	var points List[[2]point]
+	require.NoError(test, points.UnmarshalBinary(data))
+	require.Equal(test, List[[2]point]{{{X: 1, Y: 2}, {X: 3, Y: 4}}}, points)
+
+	type hidden struct{ x int32 }
+	_, err = List[hidden]{{x: 1}}.MarshalBinary()
+	require.ErrorIs(test, err, ErrUnsupportedType)
+	_, err = Map[int, [1]struct{ Inner hidden }]{0: {}}.MarshalBinary()
+	require.ErrorIs(test, err, ErrUnsupportedType)
+	data, err = List[struct{ X int32 }]{{X: 1}}.MarshalBinary()
+	require.NoError(test, err)
+	var values List[hidden]
+	require.ErrorIs(test, values.UnmarshalBinary(data), ErrUnsupportedType)
+}
+
+func TestReadBinary(test *testing.T) {
+	test.Parallel()
+
+	data, err := List[int64]{300}.MarshalBinary()
+	require.NoError(test, err)
+	var narrow List[int8]
+	require.ErrorIs(test, narrow.UnmarshalBinary(data), ErrOverflow)
+	require.Nil(test, narrow)
+	var wide List[int16]
+	require.NoError(test, wide.UnmarshalBinary(data))
+	require.True(test, wide.Equal(300))
+	data, err = List[int64]{math.MinInt8, math.MaxInt8}.MarshalBinary()
+	require.NoError(test, err)
+	require.NoError(test, narrow.UnmarshalBinary(data))
+	require.True(test, narrow.Equal(math.MinInt8, math.MaxInt8))
+	data, err = Set[uint64]{math.MaxUint16 + 1: {}}.MarshalBinary()
+	require.NoError(test, err)
+	var unsigned Set[uint16]
+	require.ErrorIs(test, unsigned.UnmarshalBinary(data), ErrOverflow)
+	data, err = Map[uint, int]{math.MaxUint8: math.MaxInt8 + 1}.MarshalBinary()
+	require.NoError(test, err)
+	var mixed Map[uint8, int8]
+	require.ErrorIs(test, mixed.UnmarshalBinary(data), ErrOverflow)
+}
+
+func TestReadBinaryHeader(test *testing.T) {
+	test.Parallel()
+
+	data, err := List[struct{}]{{}, {}}.MarshalBinary()
+	require.NoError(test, err)
+	var list List[struct{}]
+	require.NoError(test, list.UnmarshalBinary(data))
+	require.Len(test, list, 2)
+	data, err = Set[struct{}]{{}: {}}.MarshalBinary()
+	require.NoError(test, err)
+	var set Set[struct{}]
+	require.NoError(test, set.UnmarshalBinary(data))
+	require.True(test, set.Equal(struct{}{}))
+	data, err = Map[[0]int32, struct{}]{{}: {}}.MarshalBinary()
+	require.NoError(test, err)
+	var elements Map[[0]int32, struct{}]
+	require.NoError(test, elements.UnmarshalBinary(data))
+	require.Len(test, elements, 1)
+	forged := appendBinaryHeader(nil, math.MaxInt32)
+	require.NoError(test, set.UnmarshalBinary(forged))
+	require.Len(test, set, 1)
+	var ints List[int]
+	require.ErrorIs(test, ints.UnmarshalBinary(forged), io.ErrUnexpectedEOF)
+	_, empty, err := readBinaryHeader(bytes.NewReader(forged), reflect.TypeOf(0))
+	require.ErrorIs(test, err, io.ErrUnexpectedEOF)
+	require.False(test, empty)
+	huge := []byte{binaryVersion, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}
+	_, empty, err = readBinaryHeader(bytes.NewReader(huge), reflect.TypeOf(struct{}{}))
+	require.ErrorIs(test, err, io.ErrUnexpectedEOF)
+	require.False(test, empty)
+}
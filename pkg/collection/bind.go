@@ -0,0 +1,140 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// bindTag is the struct tag that overrides the key of a field when binding
+// maps to structs and structs to maps. A tag of "-" skips the field.
+const bindTag = "collection"
+
+// bindStruct sets the fields of the specified struct to the values of the
+// specified map with matching keys, using the specified coercion function to
+// transform each value before it is assigned.
+func bindStruct(
+	elements reflect.Value, target reflect.Value, coerce func(value any, kind reflect.Type) (result any, err error),
+) (err error) {
+	kind := target.Type()
+	for index := 0; index < kind.NumField(); index++ {
+		field := kind.Field(index)
+		name, skip := bindName(field)
+		if skip {
+			continue
+		}
+		element := bindLookup(elements, name)
+		if !element.IsValid() {
+			continue
+		}
+		if err = bindValue(element.Interface(), target.Field(index), coerce); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// bindFits returns true if the specified number can be converted to the
+// specified numeric type without overflowing it or losing a fractional part.
+func bindFits(current reflect.Value, kind reflect.Type) (fits bool) {
+	target := reflect.Zero(kind)
+	switch {
+	case current.CanInt() && target.CanInt():
+		return !target.OverflowInt(current.Int())
+	case current.CanInt() && target.CanUint():
+		return current.Int() >= 0 && !target.OverflowUint(uint64(current.Int()))
+	case current.CanUint() && target.CanInt():
+		return current.Uint() <= math.MaxInt64 && !target.OverflowInt(int64(current.Uint()))
+	case current.CanUint() && target.CanUint():
+		return !target.OverflowUint(current.Uint())
+	case !current.CanFloat():
+		return true
+	case target.CanFloat():
+		return !target.OverflowFloat(current.Float())
+	}
+	// The range checks keep the conversion of the float to an integer defined.
+	number := current.Float()
+	if number != math.Trunc(number) {
+		return false
+	} else if target.CanInt() {
+		return number >= -1<<63 && number < 1<<63 && !target.OverflowInt(int64(number))
+	}
+	return number >= 0 && number < 1<<64 && !target.OverflowUint(uint64(number))
+}
+
+// bindLookup returns the value of the specified map whose key matches the
+// specified name, preferring an exact match to a case-insensitive match.
+func bindLookup(elements reflect.Value, name string) (element reflect.Value) {
+	key := reflect.ValueOf(name).Convert(elements.Type().Key())
+	if element = elements.MapIndex(key); element.IsValid() {
+		return element
+	}
+	iterator := elements.MapRange()
+	for iterator.Next() {
+		if strings.EqualFold(iterator.Key().String(), name) {
+			return iterator.Value()
+		}
+	}
+	return element
+}
+
+// bindName returns the key of the specified field, or true if the field should
+// be skipped.
+func bindName(field reflect.StructField) (name string, skip bool) {
+	if !field.IsExported() {
+		return "", true
+	}
+	tag, _, _ := strings.Cut(field.Tag.Get(bindTag), ",")
+	switch tag {
+	case "-":
+		return "", true
+	case "":
+		return field.Name, false
+	default:
+		return tag, false
+	}
+}
+
+// bindValue assigns the specified value to the specified field, converting
+// between numeric types and between string types, and binding maps with
+// string keys to nested structs.
+func bindValue(
+	value any, field reflect.Value, coerce func(value any, kind reflect.Type) (result any, err error),
+) (err error) {
+	if coerce != nil {
+		if value, err = coerce(value, field.Type()); err != nil {
+			return err
+		}
+	}
+	current := reflect.ValueOf(value)
+	for current.Kind() == reflect.Interface && !current.IsNil() {
+		current = current.Elem()
+	}
+	kind := field.Type()
+	switch {
+	case !current.IsValid():
+		field.Set(reflect.Zero(kind))
+	case current.Type().AssignableTo(kind):
+		field.Set(current)
+	case numericKind(current.Kind()) && numericKind(kind.Kind()):
+		if !bindFits(current, kind) {
+			return fmt.Errorf("%w: cannot convert %v to %v", ErrUnsupportedType, current, kind)
+		}
+		field.Set(current.Convert(kind))
+	case current.Kind() == reflect.String && kind.Kind() == reflect.String:
+		field.Set(current.Convert(kind))
+	case current.Kind() == reflect.Map && current.Type().Key().Kind() == reflect.String && kind.Kind() == reflect.Struct:
+		return bindStruct(current, field, coerce)
+	case current.Kind() == reflect.Map && current.Type().Key().Kind() == reflect.String &&
+		kind.Kind() == reflect.Pointer && kind.Elem().Kind() == reflect.Struct:
+		target := reflect.New(kind.Elem())
+		if err = bindStruct(current, target.Elem(), coerce); err != nil {
+			return err
+		}
+		field.Set(target)
+	default:
+		return fmt.Errorf("%w: cannot assign %v to %v", ErrUnsupportedType, current.Type(), kind)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package collection
+
+// EvictionPolicy determines how a bounded collection handles a value that is
+// added when the collection is full.
+type EvictionPolicy int
+
+const (
+	// EvictReject rejects the added value with an error.
+	EvictReject EvictionPolicy = iota
+	// EvictOldest removes the oldest value to make room for the added value.
+	EvictOldest
+	// EvictNewest discards the added value without an error.
+	EvictNewest
+)
+
+// BoundedList represents an ordered collection of values with a maximum size.
+// The zero value is not usable; use NewBoundedList instead.
+type BoundedList[Value any] struct {
+	values   List[Value]
+	capacity int
+	policy   EvictionPolicy
+	evicted  func(value Value)
+}
+
+// NewBoundedList returns an empty bounded list with the specified capacity and
+// eviction policy. If the specified callback is not nil, it is performed for
+// each value that is removed or discarded by the eviction policy. A negative
+// capacity is treated as zero.
+func NewBoundedList[Value any](
+	capacity int, policy EvictionPolicy, evicted func(value Value),
+) (collection *BoundedList[Value]) {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &BoundedList[Value]{
+		values:   make(List[Value], 0, capacity),
+		capacity: capacity,
+		policy:   policy,
+		evicted:  evicted,
+	}
+}
+
+// Add adds the specified value to the end of the list, applying the eviction
+// policy if the list is full.
+func (collection *BoundedList[Value]) Add(value Value) (err error) {
+	if len(collection.values) < collection.capacity {
+		collection.values = append(collection.values, value)
+		return nil
+	}
+	switch collection.policy {
+	case EvictOldest:
+		if len(collection.values) == 0 {
+			collection.evict(value)
+			return nil
+		}
+		var empty Value
+		oldest := collection.values[0]
+		collection.values[0] = empty
+		collection.values = append(collection.values[1:], value)
+		collection.evict(oldest)
+	case EvictNewest:
+		collection.evict(value)
+	default:
+		return ErrCapacityExceeded
+	}
+	return nil
+}
+
+// AddAll adds all of the specified values to the end of the list, applying the
+// eviction policy to each value. If a value is rejected, the remaining values
+// are not added.
+func (collection *BoundedList[Value]) AddAll(values ...Value) (err error) {
+	for _, value := range values {
+		if err = collection.Add(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Capacity returns the maximum number of values in the list.
+func (collection *BoundedList[Value]) Capacity() (capacity int) {
+	return collection.capacity
+}
+
+// Clear removes all of the values from the list.
+func (collection *BoundedList[Value]) Clear() (modified bool) {
+	modified = len(collection.values) > 0
+	collection.values = make(List[Value], 0, collection.capacity)
+	return modified
+}
+
+// Get returns the value at the specified position in the list, where the
+// oldest value is at position zero.
+func (collection *BoundedList[Value]) Get(index int) (current Value, err error) {
+	return collection.values.Get(index)
+}
+
+// IsFull returns true if the list contains the maximum number of values.
+func (collection *BoundedList[Value]) IsFull() (full bool) {
+	return len(collection.values) >= collection.capacity
+}
+
+// Size returns the number of values in the list.
+func (collection *BoundedList[Value]) Size() (size int) {
+	return len(collection.values)
+}
+
+// Slice returns a slice containing all of the values in the list, from oldest
+// to newest.
+func (collection *BoundedList[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// evict performs the eviction callback for the specified value.
+func (collection *BoundedList[Value]) evict(value Value) {
+	if collection.evicted != nil {
+		collection.evicted(value)
+	}
+}
@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoundedList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictReject, nil)
+	require.Equal(test, 2, collection.Capacity())
+	require.Equal(test, 0, collection.Size())
+
+	collection = NewBoundedList[int](-1, EvictReject, nil)
+	require.Equal(test, 0, collection.Capacity())
+}
+
+func TestBoundedList_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictReject, nil)
+	require.NoError(test, collection.Add(0))
+	require.NoError(test, collection.Add(1))
+	require.ErrorIs(test, collection.Add(2), ErrCapacityExceeded)
+	require.Equal(test, []int{0, 1}, collection.Slice())
+
+	evicted := make([]int, 0)
+	collection = NewBoundedList(2, EvictOldest, func(value int) { evicted = append(evicted, value) })
+	for value := 0; value < 10; value++ {
+		require.NoError(test, collection.Add(value))
+	}
+	require.Equal(test, []int{8, 9}, collection.Slice())
+	require.Equal(test, []int{0, 1, 2, 3, 4, 5, 6, 7}, evicted)
+
+	evicted = make([]int, 0)
+	collection = NewBoundedList(2, EvictNewest, func(value int) { evicted = append(evicted, value) })
+	require.NoError(test, collection.AddAll(0, 1, 2, 3))
+	require.Equal(test, []int{0, 1}, collection.Slice())
+	require.Equal(test, []int{2, 3}, evicted)
+
+	collection = NewBoundedList[int](0, EvictOldest, nil)
+	require.NoError(test, collection.Add(0))
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestBoundedList_AddAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictReject, nil)
+	require.ErrorIs(test, collection.AddAll(0, 1, 2, 3), ErrCapacityExceeded)
+	require.Equal(test, []int{0, 1}, collection.Slice())
+}
+
+func TestBoundedList_Capacity(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](1, EvictOldest, nil)
+	require.NoError(test, collection.AddAll(0, 1))
+	require.Equal(test, 1, collection.Capacity())
+}
+
+func TestBoundedList_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](1, EvictReject, nil)
+	require.False(test, collection.Clear())
+	require.NoError(test, collection.Add(0))
+	require.True(test, collection.Clear())
+	require.NoError(test, collection.Add(1))
+}
+
+func TestBoundedList_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictOldest, nil)
+	require.NoError(test, collection.AddAll(0, 1, 2))
+	current, err := collection.Get(0)
+	require.NoError(test, err)
+	require.Equal(test, 1, current)
+	_, err = collection.Get(2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestBoundedList_IsFull(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](1, EvictReject, nil)
+	require.False(test, collection.IsFull())
+	require.NoError(test, collection.Add(0))
+	require.True(test, collection.IsFull())
+}
+
+func TestBoundedList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictOldest, nil)
+	require.NoError(test, collection.AddAll(0, 1, 2))
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestBoundedList_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewBoundedList[int](2, EvictOldest, nil)
+	require.NoError(test, collection.AddAll(0, 1, 2))
+	values := collection.Slice()
+	values[0] = 0
+	require.Equal(test, []int{1, 2}, collection.Slice())
+}
@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// builderPadding is the number of bytes that separate the fields of adjacent
+// shards, which keeps them on different cache lines.
+const builderPadding = 64
+
+// builderShard represents a portion of the values of a builder.
+type builderShard[Value any] struct {
+	lock   sync.Mutex
+	values []Value
+	_      [builderPadding]byte
+}
+
+// Builder represents a collection that accumulates values from multiple
+// goroutines by distributing them across independently locked shards, and
+// then combines them into a list or set in a single pass. The order in which
+// values are added is not preserved. The builder is safe for concurrent use.
+// The zero value is not usable; use NewBuilder instead.
+type Builder[Value any] struct {
+	shards []builderShard[Value]
+}
+
+// BuildSet returns a set containing the values added to the specified builder.
+func BuildSet[Value comparable](builder *Builder[Value]) (collection Set[Value]) {
+	collection = make(Set[Value], builder.Size())
+	builder.forEachShard(func(values []Value) {
+		for _, value := range values {
+			collection[value] = struct{}{}
+		}
+	})
+	return collection
+}
+
+// NewBuilder returns an empty builder with the specified number of shards. If
+// the number of shards is less than one, GOMAXPROCS shards are used.
+func NewBuilder[Value any](shards int) (collection *Builder[Value]) {
+	if shards < 1 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+	return &Builder[Value]{shards: make([]builderShard[Value], shards)}
+}
+
+// Add adds the specified value to the builder.
+func (collection *Builder[Value]) Add(value Value) {
+	shard := collection.shard()
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	shard.values = append(shard.values, value)
+}
+
+// AddBatch adds all of the specified values to the builder, acquiring a single
+// shard once for the whole batch.
+func (collection *Builder[Value]) AddBatch(values []Value) {
+	shard := collection.shard()
+	shard.lock.Lock()
+	defer shard.lock.Unlock()
+	shard.values = append(shard.values, values...)
+}
+
+// Build returns a list containing the values added to the builder. The values
+// are grouped by shard, so the order in which they were added is not
+// preserved.
+func (collection *Builder[Value]) Build() (values List[Value]) {
+	values = make(List[Value], 0, collection.Size())
+	collection.forEachShard(func(shard []Value) {
+		values = append(values, shard...)
+	})
+	return values
+}
+
+// Reset removes all of the values from the builder.
+func (collection *Builder[Value]) Reset() {
+	for index := range collection.shards {
+		shard := &collection.shards[index]
+		shard.lock.Lock()
+		shard.values = nil
+		shard.lock.Unlock()
+	}
+}
+
+// Size returns the number of values added to the builder.
+func (collection *Builder[Value]) Size() (size int) {
+	collection.forEachShard(func(values []Value) {
+		size += len(values)
+	})
+	return size
+}
+
+// forEachShard performs the specified action for the values of each shard
+// while holding the lock of the shard.
+func (collection *Builder[Value]) forEachShard(action func(values []Value)) {
+	for index := range collection.shards {
+		shard := &collection.shards[index]
+		shard.lock.Lock()
+		action(shard.values)
+		shard.lock.Unlock()
+	}
+}
+
+// shard returns a random shard. Unless the program seeds it, the default
+// source of randomness keeps its state per thread, so unlike a shared counter,
+// choosing a shard does not contend between goroutines.
+func (collection *Builder[Value]) shard() (shard *builderShard[Value]) {
+	return &collection.shards[rand.Intn(len(collection.shards))] //nolint:gosec
+}
@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSet(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](2)
+	builder.AddBatch([]int{0, 1, 1})
+	builder.Add(2)
+	require.True(test, BuildSet(builder).Equal(0, 1, 2))
+}
+
+func TestNewBuilder(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](0)
+	require.Equal(test, 0, builder.Size())
+	require.True(test, builder.Build().IsEmpty())
+}
+
+func TestBuilder_Add(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](4)
+	var group sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			for index := 0; index < 100; index++ {
+				builder.Add(worker*100 + index)
+			}
+		}(worker)
+	}
+	group.Wait()
+	require.Equal(test, 800, builder.Size())
+	require.ElementsMatch(test, RangeList(0, 800, 1), builder.Build())
+}
+
+func TestBuilder_AddBatch(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](1)
+	builder.AddBatch([]int{0, 1})
+	builder.AddBatch(nil)
+	builder.AddBatch([]int{2})
+	require.True(test, builder.Build().Equal(0, 1, 2))
+}
+
+func TestBuilder_Build(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](2)
+	builder.AddBatch([]int{0, 1})
+	builder.Add(2)
+	collection := builder.Build()
+	require.ElementsMatch(test, []int{0, 1, 2}, collection)
+	collection[0] = 3
+	require.ElementsMatch(test, []int{0, 1, 2}, builder.Build())
+}
+
+func TestBuilder_Reset(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](2)
+	builder.AddBatch([]int{0, 1})
+	builder.Reset()
+	require.Equal(test, 0, builder.Size())
+}
+
+func TestBuilder_Size(test *testing.T) {
+	test.Parallel()
+
+	builder := NewBuilder[int](2)
+	builder.Add(0)
+	builder.AddBatch([]int{1, 2})
+	require.Equal(test, 3, builder.Size())
+}
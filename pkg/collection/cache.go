@@ -0,0 +1,177 @@
+package collection
+
+import (
+	"container/list"
+	"time"
+)
+
+// EvictionReason indicates why an entry was removed from a cache.
+type EvictionReason int
+
+const (
+	// EvictionCapacity indicates that the entry was the least recently used
+	// entry when the cache exceeded its capacity.
+	EvictionCapacity EvictionReason = iota
+	// EvictionExpired indicates that the time to live of the entry elapsed.
+	EvictionExpired
+	// EvictionInvalidated indicates that the entry was invalidated.
+	EvictionInvalidated
+	// EvictionReplaced indicates that the value of the entry was replaced.
+	EvictionReplaced
+)
+
+// String returns a string representation of the reason.
+func (reason EvictionReason) String() (name string) {
+	switch reason {
+	case EvictionCapacity:
+		return "capacity"
+	case EvictionExpired:
+		return "expired"
+	case EvictionInvalidated:
+		return "invalidated"
+	case EvictionReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
+
+// cacheEntry represents an entry of a cache and the time that it expires,
+// which is zero if the entry does not expire.
+type cacheEntry[Key comparable, Value any] struct {
+	key     Key
+	value   Value
+	expires time.Time
+}
+
+// expired returns true if the entry has expired at the specified time.
+func (entry *cacheEntry[Key, Value]) expired(now time.Time) (expired bool) {
+	return !entry.expires.IsZero() && !now.Before(entry.expires)
+}
+
+// Cache represents a map that evicts the least recently used entry once it
+// exceeds its capacity, and evicts each entry once its time to live elapses.
+// Expired entries are evicted when they are accessed or when Cleanup is called.
+// The cache is not safe for concurrent use. The zero value is not usable; use
+// NewCache instead.
+type Cache[Key comparable, Value any] struct {
+	elements map[Key]*list.Element
+	order    *list.List
+	capacity int
+	ttl      time.Duration
+	clock    func() (now time.Time)
+	evicted  func(key Key, value Value, reason EvictionReason)
+}
+
+// NewCache returns an empty cache with the specified capacity and default time
+// to live, measured by the specified clock. A capacity less than one is
+// unbounded, a time to live that is not positive never expires, and a nil
+// clock uses time.Now. If the specified listener is not nil, it is called with
+// each entry that is removed from the cache and the reason for its removal.
+func NewCache[Key comparable, Value any](
+	capacity int, ttl time.Duration, clock func() (now time.Time),
+	evicted func(key Key, value Value, reason EvictionReason),
+) (collection *Cache[Key, Value]) {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &Cache[Key, Value]{
+		elements: make(map[Key]*list.Element),
+		order:    list.New(),
+		capacity: capacity,
+		ttl:      ttl,
+		clock:    clock,
+		evicted:  evicted,
+	}
+}
+
+// Cleanup evicts all expired entries from the cache, returning the number of
+// entries evicted.
+func (collection *Cache[Key, Value]) Cleanup() (removed int) {
+	now := collection.clock()
+	for element := collection.order.Back(); element != nil; {
+		previous := element.Prev()
+		if entry, _ := element.Value.(*cacheEntry[Key, Value]); entry.expired(now) {
+			collection.remove(element, EvictionExpired)
+			removed++
+		}
+		element = previous
+	}
+	return removed
+}
+
+// GetIfPresent returns the value associated with the specified key and true if
+// the cache contains an unexpired entry for the key, marking the entry as most
+// recently used. Otherwise, the zero value and false are returned.
+func (collection *Cache[Key, Value]) GetIfPresent(key Key) (current Value, exists bool) {
+	element, exists := collection.elements[key]
+	if !exists {
+		return current, false
+	}
+	entry, _ := element.Value.(*cacheEntry[Key, Value])
+	if entry.expired(collection.clock()) {
+		collection.remove(element, EvictionExpired)
+		return current, false
+	}
+	collection.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Invalidate removes the specified key from the cache, returning true if the
+// cache contained the key.
+func (collection *Cache[Key, Value]) Invalidate(key Key) (modified bool) {
+	element, exists := collection.elements[key]
+	if exists {
+		collection.remove(element, EvictionInvalidated)
+	}
+	return exists
+}
+
+// InvalidateAll removes all of the entries from the cache.
+func (collection *Cache[Key, Value]) InvalidateAll() (modified bool) {
+	modified = collection.order.Len() > 0
+	for collection.order.Len() > 0 {
+		collection.remove(collection.order.Back(), EvictionInvalidated)
+	}
+	return modified
+}
+
+// Put associates the specified value with the specified key in the cache,
+// using the default time to live, and marks the entry as most recently used.
+func (collection *Cache[Key, Value]) Put(key Key, value Value) {
+	collection.PutWithTTL(key, value, collection.ttl)
+}
+
+// PutWithTTL associates the specified value with the specified key in the
+// cache, using the specified time to live, and marks the entry as most
+// recently used. A time to live that is not positive never expires. If the
+// cache exceeds its capacity, the least recently used entry is evicted.
+func (collection *Cache[Key, Value]) PutWithTTL(key Key, value Value, ttl time.Duration) {
+	entry := &cacheEntry[Key, Value]{key: key, value: value, expires: time.Time{}}
+	if ttl > 0 {
+		entry.expires = collection.clock().Add(ttl)
+	}
+	if element, exists := collection.elements[key]; exists {
+		collection.remove(element, EvictionReplaced)
+	}
+	collection.elements[key] = collection.order.PushFront(entry)
+	if collection.capacity > 0 && collection.order.Len() > collection.capacity {
+		collection.remove(collection.order.Back(), EvictionCapacity)
+	}
+}
+
+// Size returns the number of entries in the cache, including expired entries
+// that have not yet been evicted.
+func (collection *Cache[Key, Value]) Size() (size int) {
+	return collection.order.Len()
+}
+
+// remove removes the specified element from the cache and notifies the
+// listener, if any, with the specified reason.
+func (collection *Cache[Key, Value]) remove(element *list.Element, reason EvictionReason) {
+	entry, _ := collection.order.Remove(element).(*cacheEntry[Key, Value])
+	delete(collection.elements, entry.key)
+	if collection.evicted != nil {
+		collection.evicted(entry.key, entry.value, reason)
+	}
+}
@@ -0,0 +1,135 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// evictionRecorder returns a listener that records each eviction in the
+// specified list.
+func evictionRecorder(evictions *[]string) func(key string, value int, reason EvictionReason) {
+	return func(key string, value int, reason EvictionReason) {
+		*evictions = append(*evictions, fmt.Sprintf("%s=%d %v", key, value, reason))
+	}
+}
+
+func TestEvictionReason_String(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, "capacity", EvictionCapacity.String())
+	require.Equal(test, "expired", EvictionExpired.String())
+	require.Equal(test, "invalidated", EvictionInvalidated.String())
+	require.Equal(test, "replaced", EvictionReplaced.String())
+	require.Equal(test, "unknown", EvictionReason(-1).String())
+}
+
+func TestNewCache(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCache[string, int](0, 0, nil, nil)
+	collection.Put("a", 0)
+	value, exists := collection.GetIfPresent("a")
+	require.True(test, exists)
+	require.Equal(test, 0, value)
+}
+
+func TestCache_Cleanup(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	evictions := make([]string, 0)
+	collection := NewCache(0, time.Minute, fakeClock(&now), evictionRecorder(&evictions))
+	collection.Put("a", 0)
+	collection.PutWithTTL("b", 1, 0)
+	collection.PutWithTTL("c", 2, time.Hour)
+	now = now.Add(time.Minute)
+	require.Equal(test, 1, collection.Cleanup())
+	require.Equal(test, []string{"a=0 expired"}, evictions)
+	require.Equal(test, 2, collection.Size())
+	require.Equal(test, 0, collection.Cleanup())
+}
+
+func TestCache_GetIfPresent(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	evictions := make([]string, 0)
+	collection := NewCache(2, time.Minute, fakeClock(&now), evictionRecorder(&evictions))
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	_, exists := collection.GetIfPresent("a")
+	require.True(test, exists)
+	collection.Put("c", 2)
+	_, exists = collection.GetIfPresent("b")
+	require.False(test, exists)
+	now = now.Add(time.Minute)
+	_, exists = collection.GetIfPresent("a")
+	require.False(test, exists)
+	require.Equal(test, []string{"b=1 capacity", "a=0 expired"}, evictions)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestCache_Invalidate(test *testing.T) {
+	test.Parallel()
+
+	evictions := make([]string, 0)
+	collection := NewCache(0, 0, nil, evictionRecorder(&evictions))
+	collection.Put("a", 0)
+	require.True(test, collection.Invalidate("a"))
+	require.False(test, collection.Invalidate("a"))
+	require.Equal(test, []string{"a=0 invalidated"}, evictions)
+}
+
+func TestCache_InvalidateAll(test *testing.T) {
+	test.Parallel()
+
+	evictions := make([]string, 0)
+	collection := NewCache(0, 0, nil, evictionRecorder(&evictions))
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	require.True(test, collection.InvalidateAll())
+	require.False(test, collection.InvalidateAll())
+	require.Equal(test, []string{"a=0 invalidated", "b=1 invalidated"}, evictions)
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestCache_Put(test *testing.T) {
+	test.Parallel()
+
+	evictions := make([]string, 0)
+	collection := NewCache(2, 0, nil, evictionRecorder(&evictions))
+	collection.Put("a", 0)
+	collection.Put("a", 1)
+	collection.Put("b", 2)
+	collection.Put("c", 3)
+	require.Equal(test, []string{"a=0 replaced", "a=1 capacity"}, evictions)
+	value, exists := collection.GetIfPresent("c")
+	require.True(test, exists)
+	require.Equal(test, 3, value)
+}
+
+func TestCache_PutWithTTL(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewCache[string, int](0, time.Hour, fakeClock(&now), nil)
+	collection.PutWithTTL("a", 0, time.Second)
+	collection.PutWithTTL("b", 1, -1)
+	now = now.Add(24 * time.Hour)
+	_, exists := collection.GetIfPresent("a")
+	require.False(test, exists)
+	_, exists = collection.GetIfPresent("b")
+	require.True(test, exists)
+}
+
+func TestCache_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCache[string, int](1, 0, nil, nil)
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	require.Equal(test, 1, collection.Size())
+}
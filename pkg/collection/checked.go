@@ -0,0 +1,210 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCapacityExceeded indicates that a collection would exceed its capacity.
+var ErrCapacityExceeded = errors.New("capacity exceeded")
+
+// ValidationError indicates that a value was rejected by a validator.
+type ValidationError struct {
+	Value any
+	Err   error
+}
+
+// Error returns a string representation of the error.
+func (err *ValidationError) Error() (message string) {
+	return fmt.Sprintf("invalid value %v: %v", err.Value, err.Err)
+}
+
+// Unwrap returns the error returned by the validator.
+func (err *ValidationError) Unwrap() (cause error) {
+	return err.Err
+}
+
+// CheckedList represents a list that rejects values that fail validation, and
+// modifications that would exceed its capacity. The zero value is not usable;
+// use NewCheckedList instead.
+type CheckedList[Value any] struct {
+	values   List[Value]
+	validate func(value Value) (err error)
+	capacity int
+}
+
+// NewCheckedList returns an empty checked list with the specified validator
+// and capacity. A nil validator accepts all values, and a capacity less than
+// one is unbounded.
+func NewCheckedList[Value any](validate func(value Value) (err error), capacity int) (collection *CheckedList[Value]) {
+	return &CheckedList[Value]{values: make(List[Value], 0), validate: validate, capacity: capacity}
+}
+
+// Add adds the specified value to the end of the list.
+func (collection *CheckedList[Value]) Add(value Value) (err error) {
+	return collection.InsertAll(len(collection.values), value)
+}
+
+// AddAll adds all of the specified values to the end of the list. If any value
+// is rejected, none of the values are added.
+func (collection *CheckedList[Value]) AddAll(values ...Value) (err error) {
+	return collection.InsertAll(len(collection.values), values...)
+}
+
+// Clear removes all of the values from the list.
+func (collection *CheckedList[Value]) Clear() (modified bool) {
+	return collection.values.Clear()
+}
+
+// Delete removes the value at the specified position in the list, returning
+// the previous value.
+func (collection *CheckedList[Value]) Delete(index int) (previous Value, err error) {
+	return collection.values.Delete(index)
+}
+
+// Get returns the value at the specified position in the list.
+func (collection *CheckedList[Value]) Get(index int) (current Value, err error) {
+	return collection.values.Get(index)
+}
+
+// Insert adds the specified value to the list at the specified position.
+func (collection *CheckedList[Value]) Insert(index int, value Value) (err error) {
+	return collection.InsertAll(index, value)
+}
+
+// InsertAll adds all of the specified values to the list at the specified
+// position. If any value is rejected, none of the values are added.
+func (collection *CheckedList[Value]) InsertAll(index int, values ...Value) (err error) {
+	if collection.capacity > 0 && len(collection.values)+len(values) > collection.capacity {
+		return ErrCapacityExceeded
+	}
+	for _, value := range values {
+		if err = collection.check(value); err != nil {
+			return err
+		}
+	}
+	return collection.values.InsertAll(index, values...)
+}
+
+// Set replaces the value at the specified position in the list with the
+// specified value.
+func (collection *CheckedList[Value]) Set(index int, value Value) (err error) {
+	if err = collection.check(value); err != nil {
+		return err
+	}
+	return collection.values.Set(index, value)
+}
+
+// Size returns the number of values in the list.
+func (collection *CheckedList[Value]) Size() (size int) {
+	return len(collection.values)
+}
+
+// Slice returns a slice containing all of the values in the list.
+func (collection *CheckedList[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// check returns a validation error if the specified value is rejected.
+func (collection *CheckedList[Value]) check(value Value) (err error) {
+	if collection.validate == nil {
+		return nil
+	} else if err = collection.validate(value); err != nil {
+		return &ValidationError{Value: value, Err: err}
+	}
+	return nil
+}
+
+// CheckedMap represents a map that rejects keys and values that fail
+// validation, and modifications that would exceed its capacity. The zero value
+// is not usable; use NewCheckedMap instead.
+type CheckedMap[Key comparable, Value any] struct {
+	elements      Map[Key, Value]
+	validateKey   func(key Key) (err error)
+	validateValue func(value Value) (err error)
+	capacity      int
+}
+
+// NewCheckedMap returns an empty checked map with the specified validators and
+// capacity. A nil validator accepts all keys or values, and a capacity less
+// than one is unbounded.
+func NewCheckedMap[Key comparable, Value any](
+	validateKey func(key Key) (err error), validateValue func(value Value) (err error), capacity int,
+) (collection *CheckedMap[Key, Value]) {
+	return &CheckedMap[Key, Value]{
+		elements:      make(Map[Key, Value]),
+		validateKey:   validateKey,
+		validateValue: validateValue,
+		capacity:      capacity,
+	}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *CheckedMap[Key, Value]) Clear() (modified bool) {
+	return collection.elements.Clear()
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (collection *CheckedMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	return collection.elements.ContainsKey(key)
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (collection *CheckedMap[Key, Value]) Get(key Key) (current Value) {
+	return collection.elements.Get(key)
+}
+
+// Map returns a map containing all of the elements in the map.
+func (collection *CheckedMap[Key, Value]) Map() (elements map[Key]Value) {
+	return collection.elements.Map()
+}
+
+// Put associates the specified value with the specified key in the map.
+func (collection *CheckedMap[Key, Value]) Put(key Key, value Value) (err error) {
+	return collection.PutAll(map[Key]Value{key: value})
+}
+
+// PutAll associates all of the specified values with the specified keys in the
+// map. If any key or value is rejected, none of the elements are added.
+func (collection *CheckedMap[Key, Value]) PutAll(elements map[Key]Value) (err error) {
+	size := len(collection.elements)
+	for key, value := range elements {
+		if _, exists := collection.elements[key]; !exists {
+			size++
+		}
+		if err = collection.check(key, value); err != nil {
+			return err
+		}
+	}
+	if collection.capacity > 0 && size > collection.capacity {
+		return ErrCapacityExceeded
+	}
+	collection.elements.PutAll(elements)
+	return nil
+}
+
+// Remove removes the specified key from the map, returning the previous value.
+func (collection *CheckedMap[Key, Value]) Remove(key Key) (previous Value) {
+	return collection.elements.Remove(key)
+}
+
+// Size returns the number of elements in the map.
+func (collection *CheckedMap[Key, Value]) Size() (size int) {
+	return len(collection.elements)
+}
+
+// check returns a validation error if the specified key or value is rejected.
+func (collection *CheckedMap[Key, Value]) check(key Key, value Value) (err error) {
+	if collection.validateKey != nil {
+		if err = collection.validateKey(key); err != nil {
+			return &ValidationError{Value: key, Err: err}
+		}
+	}
+	if collection.validateValue != nil {
+		if err = collection.validateValue(value); err != nil {
+			return &ValidationError{Value: value, Err: err}
+		}
+	}
+	return nil
+}
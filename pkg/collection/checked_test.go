@@ -0,0 +1,224 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errNegative = errors.New("negative")
+
+func nonNegative(value int) error {
+	if value < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+func TestValidationError_Error(test *testing.T) {
+	test.Parallel()
+
+	err := &ValidationError{Value: -1, Err: errNegative}
+	require.Equal(test, "invalid value -1: negative", err.Error())
+}
+
+func TestValidationError_Unwrap(test *testing.T) {
+	test.Parallel()
+
+	var err error = &ValidationError{Value: -1, Err: errNegative}
+	require.ErrorIs(test, err, errNegative)
+
+	var validation *ValidationError
+	require.ErrorAs(test, err, &validation)
+	require.Equal(test, -1, validation.Value)
+}
+
+func TestNewCheckedList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList[int](nil, 0)
+	require.NoError(test, collection.AddAll(-1, 0, 1))
+	require.Equal(test, 3, collection.Size())
+}
+
+func TestCheckedList_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 1)
+	require.ErrorIs(test, collection.Add(-1), errNegative)
+	require.NoError(test, collection.Add(0))
+	require.ErrorIs(test, collection.Add(1), ErrCapacityExceeded)
+	require.Equal(test, []int{0}, collection.Slice())
+}
+
+func TestCheckedList_AddAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 2)
+	require.ErrorIs(test, collection.AddAll(0, -1), errNegative)
+	require.ErrorIs(test, collection.AddAll(0, 1, 2), ErrCapacityExceeded)
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.AddAll(0, 1))
+}
+
+func TestCheckedList_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 1)
+	require.NoError(test, collection.Add(0))
+	require.True(test, collection.Clear())
+	require.NoError(test, collection.Add(0))
+}
+
+func TestCheckedList_Delete(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 1)
+	require.NoError(test, collection.Add(1))
+	previous, err := collection.Delete(0)
+	require.NoError(test, err)
+	require.Equal(test, 1, previous)
+	_, err = collection.Delete(0)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestCheckedList_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 1)
+	require.NoError(test, collection.Add(1))
+	current, err := collection.Get(0)
+	require.NoError(test, err)
+	require.Equal(test, 1, current)
+}
+
+func TestCheckedList_Insert(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 0)
+	require.NoError(test, collection.Insert(0, 1))
+	require.ErrorIs(test, collection.Insert(0, -1), errNegative)
+	require.NoError(test, collection.Insert(0, 0))
+	require.Equal(test, []int{0, 1}, collection.Slice())
+}
+
+func TestCheckedList_InsertAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 0)
+	require.NoError(test, collection.InsertAll(0, 2, 3))
+	require.ErrorIs(test, collection.InsertAll(3, 0), ErrIndexOutOfRange)
+	require.NoError(test, collection.InsertAll(0, 0, 1))
+	require.Equal(test, []int{0, 1, 2, 3}, collection.Slice())
+}
+
+func TestCheckedList_Set(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 0)
+	require.NoError(test, collection.Add(0))
+	require.ErrorIs(test, collection.Set(0, -1), errNegative)
+	require.NoError(test, collection.Set(0, 1))
+	require.Equal(test, []int{1}, collection.Slice())
+}
+
+func TestCheckedList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 0)
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.Add(0))
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestCheckedList_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedList(nonNegative, 0)
+	require.NoError(test, collection.Add(0))
+	values := collection.Slice()
+	values[0] = -1
+	require.Equal(test, []int{0}, collection.Slice())
+}
+
+func TestNewCheckedMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.NoError(test, collection.Put(-1, -1))
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestCheckedMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 1)
+	require.NoError(test, collection.Put(0, 0))
+	require.True(test, collection.Clear())
+	require.NoError(test, collection.Put(1, 1))
+}
+
+func TestCheckedMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.False(test, collection.ContainsKey(0))
+	require.NoError(test, collection.Put(0, 0))
+	require.True(test, collection.ContainsKey(0))
+}
+
+func TestCheckedMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.NoError(test, collection.Put(0, 1))
+	require.Equal(test, 1, collection.Get(0))
+}
+
+func TestCheckedMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.NoError(test, collection.Put(0, 1))
+	require.Equal(test, map[int]int{0: 1}, collection.Map())
+}
+
+func TestCheckedMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap(nonNegative, nonNegative, 1)
+	require.ErrorIs(test, collection.Put(-1, 0), errNegative)
+	require.ErrorIs(test, collection.Put(0, -1), errNegative)
+	require.NoError(test, collection.Put(0, 0))
+	require.NoError(test, collection.Put(0, 1))
+	require.ErrorIs(test, collection.Put(1, 1), ErrCapacityExceeded)
+}
+
+func TestCheckedMap_PutAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap(nonNegative, nonNegative, 2)
+	require.ErrorIs(test, collection.PutAll(map[int]int{0: 0, 1: -1}), errNegative)
+	require.ErrorIs(test, collection.PutAll(map[int]int{0: 0, 1: 1, 2: 2}), ErrCapacityExceeded)
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.PutAll(map[int]int{0: 0, 1: 1}))
+}
+
+func TestCheckedMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.NoError(test, collection.Put(0, 1))
+	require.Equal(test, 1, collection.Remove(0))
+	require.False(test, collection.ContainsKey(0))
+}
+
+func TestCheckedMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewCheckedMap[int, int](nil, nil, 0)
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.Put(0, 1))
+	require.Equal(test, 1, collection.Size())
+}
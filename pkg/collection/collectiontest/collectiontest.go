@@ -0,0 +1,32 @@
+// Package collectiontest provides helpers for testing code that uses the
+// collection package. It imports the testing packages, so it should only be
+// imported by tests.
+package collectiontest
+
+import (
+	"encoding"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+// AddFuzzCorpus adds the binary encodings of the specified number of random
+// collections, each generated with up to the specified number of values, to
+// the seed corpus of the specified fuzz test. The collection type is usually a
+// List, Map, or Set, so that the fuzz target can decode its input with
+// UnmarshalBinary. An error is returned if a collection could not be encoded.
+func AddFuzzCorpus[Collection interface {
+	quick.Generator
+	encoding.BinaryMarshaler
+}](fuzz *testing.F, random *rand.Rand, size int, count int) (err error) {
+	var empty Collection
+	for index := 0; index < count; index++ {
+		collection, _ := empty.Generate(random, size).Interface().(Collection)
+		var data []byte
+		if data, err = collection.MarshalBinary(); err != nil {
+			return err
+		}
+		fuzz.Add(data)
+	}
+	return nil
+}
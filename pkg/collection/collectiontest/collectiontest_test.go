@@ -0,0 +1,27 @@
+package collectiontest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cholland1989/go-collection/pkg/collection"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzAddFuzzCorpus(fuzz *testing.F) {
+	random := rand.New(rand.NewSource(1))
+	require.NoError(fuzz, AddFuzzCorpus[collection.List[int]](fuzz, random, 8, 4))
+	require.NoError(fuzz, AddFuzzCorpus[collection.Map[string, int]](fuzz, random, 8, 4))
+	require.NoError(fuzz, AddFuzzCorpus[collection.Set[uint8]](fuzz, random, 8, 4))
+	require.Error(fuzz, AddFuzzCorpus[collection.List[map[int]int]](fuzz, random, 8, 4))
+	fuzz.Fuzz(func(test *testing.T, data []byte) {
+		var values collection.List[int]
+		if values.UnmarshalBinary(data) == nil {
+			encoded, err := values.MarshalBinary()
+			require.NoError(test, err)
+			var decoded collection.List[int]
+			require.NoError(test, decoded.UnmarshalBinary(encoded))
+			require.Equal(test, values, decoded)
+		}
+	})
+}
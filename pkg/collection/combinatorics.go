@@ -0,0 +1,81 @@
+package collection
+
+// CartesianProduct performs the specified action for each pair of values from
+// the specified lists, in order, until all pairs have been processed or the
+// action returns false.
+func CartesianProduct[This any, That any](
+	this List[This], that List[That], action func(this This, that That) (next bool),
+) {
+	for _, left := range this {
+		for _, right := range that {
+			if !action(left, right) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations performs the specified action for each combination of the
+// specified number of values from the list, in lexicographic order of
+// position, until all combinations have been processed or the action returns
+// false. The combination is only valid until the action returns.
+func Combinations[Value any](collection List[Value], size int, action func(values []Value) (next bool)) {
+	if size < 0 || size > len(collection) {
+		return
+	}
+	indexes := make([]int, size)
+	values := make([]Value, size)
+	for index := range indexes {
+		indexes[index] = index
+		values[index] = collection[index]
+	}
+	for {
+		if !action(values) {
+			return
+		}
+		// Find the rightmost position that can still be advanced.
+		index := size - 1
+		for index >= 0 && indexes[index] == len(collection)-size+index {
+			index--
+		}
+		if index < 0 {
+			return
+		}
+		indexes[index]++
+		values[index] = collection[indexes[index]]
+		for jndex := index + 1; jndex < size; jndex++ {
+			indexes[jndex] = indexes[jndex-1] + 1
+			values[jndex] = collection[indexes[jndex]]
+		}
+	}
+}
+
+// Permutations performs the specified action for each permutation of the
+// values of the list until all permutations have been processed or the action
+// returns false. The permutation is only valid until the action returns.
+func Permutations[Value any](collection List[Value], action func(values []Value) (next bool)) {
+	values := append(make([]Value, 0, len(collection)), collection...)
+	if !action(values) {
+		return
+	}
+	// Heap's algorithm, where counters[index] tracks the swaps performed at
+	// each position.
+	counters := make([]int, len(values))
+	for index := 1; index < len(values); {
+		if counters[index] < index {
+			if index%2 == 0 {
+				values[0], values[index] = values[index], values[0]
+			} else {
+				values[counters[index]], values[index] = values[index], values[counters[index]]
+			}
+			if !action(values) {
+				return
+			}
+			counters[index]++
+			index = 1
+		} else {
+			counters[index] = 0
+			index++
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartesianProduct(test *testing.T) {
+	test.Parallel()
+
+	pairs := make([]string, 0)
+	CartesianProduct(ListOf(0, 1), ListOf("a", "b"), func(this int, that string) bool {
+		pairs = append(pairs, string(rune('0'+this))+that)
+		return true
+	})
+	require.Equal(test, []string{"0a", "0b", "1a", "1b"}, pairs)
+
+	count := 0
+	CartesianProduct(ListOf(0, 1), ListOf(0, 1), func(_ int, _ int) bool {
+		count++
+		return false
+	})
+	require.Equal(test, 1, count)
+}
+
+func TestCombinations(test *testing.T) {
+	test.Parallel()
+
+	combinations := make([][]int, 0)
+	Combinations(ListOf(0, 1, 2, 3), 2, func(values []int) bool {
+		combinations = append(combinations, append([]int(nil), values...))
+		return true
+	})
+	require.Equal(test, [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}, combinations)
+
+	combinations = combinations[:0]
+	Combinations(ListOf(0, 1), 0, func(values []int) bool {
+		combinations = append(combinations, append([]int(nil), values...))
+		return true
+	})
+	require.Equal(test, [][]int{nil}, combinations)
+
+	count := 0
+	Combinations(ListOf(0, 1), 3, func(_ []int) bool {
+		count++
+		return true
+	})
+	Combinations(ListOf(0, 1, 2), 1, func(_ []int) bool {
+		count++
+		return false
+	})
+	require.Equal(test, 1, count)
+}
+
+func TestPermutations(test *testing.T) {
+	test.Parallel()
+
+	permutations := SetOf[[3]int]()
+	collection := ListOf(0, 1, 2)
+	Permutations(collection, func(values []int) bool {
+		permutations.Add([3]int{values[0], values[1], values[2]})
+		return true
+	})
+	require.Equal(test, 6, permutations.Size())
+	require.Equal(test, List[int]{0, 1, 2}, collection)
+
+	count := 0
+	Permutations(collection, func(_ []int) bool {
+		count++
+		return count < 2
+	})
+	require.Equal(test, 2, count)
+
+	empty := make([][]int, 0)
+	Permutations(List[int]{}, func(values []int) bool {
+		empty = append(empty, values)
+		return true
+	})
+	require.Len(test, empty, 1)
+}
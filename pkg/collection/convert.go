@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConvertMode determines how a conversion handles values that fail to convert.
+type ConvertMode int
+
+const (
+	// ConvertFailFast stops at the first error and returns no result.
+	ConvertFailFast ConvertMode = iota
+	// ConvertCollectErrors converts every value and returns no result if any
+	// conversion failed, with all of the errors joined.
+	ConvertCollectErrors
+	// ConvertSkipErrors omits the values that failed to convert from the
+	// result, and returns the result together with all of the errors joined.
+	ConvertSkipErrors
+)
+
+// ConvertList returns a list containing the results of applying the specified
+// conversion to each value of the specified list, handling errors according to
+// the specified mode. Each error is annotated with the index of its value, and
+// errors are joined in list order.
+func ConvertList[In any, Out any](
+	collection List[In], convert func(value In) (result Out, err error), mode ConvertMode,
+) (results List[Out], err error) {
+	results = make(List[Out], 0, len(collection))
+	errs := make([]error, 0)
+	for index, value := range collection {
+		result, convertErr := convert(value)
+		if convertErr == nil {
+			results = append(results, result)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("index %d: %w", index, convertErr))
+		if mode == ConvertFailFast {
+			break
+		}
+	}
+	if err = errors.Join(errs...); err != nil && mode != ConvertSkipErrors {
+		return nil, err
+	}
+	return results, err
+}
+
+// ConvertMapValues returns a map that associates the keys of the specified map
+// with the results of applying the specified conversion to their values,
+// handling errors according to the specified mode. Each error is annotated with
+// the key of its value, and errors are joined in an arbitrary order.
+func ConvertMapValues[Key comparable, In any, Out any](
+	collection Map[Key, In], convert func(value In) (result Out, err error), mode ConvertMode,
+) (results Map[Key, Out], err error) {
+	results = make(Map[Key, Out], len(collection))
+	errs := make([]error, 0)
+	for key, value := range collection {
+		result, convertErr := convert(value)
+		if convertErr == nil {
+			results[key] = result
+			continue
+		}
+		errs = append(errs, fmt.Errorf("key %v: %w", key, convertErr))
+		if mode == ConvertFailFast {
+			break
+		}
+	}
+	if err = errors.Join(errs...); err != nil && mode != ConvertSkipErrors {
+		return nil, err
+	}
+	return results, err
+}
@@ -0,0 +1,61 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertList(test *testing.T) {
+	test.Parallel()
+
+	convert := func(value int) (string, error) {
+		if value < 0 {
+			return "", errNegative
+		}
+		return fmt.Sprint(value), nil
+	}
+	results, err := ConvertList(ListOf(0, 1), convert, ConvertFailFast)
+	require.NoError(test, err)
+	require.True(test, results.Equal("0", "1"))
+	results, err = ConvertList(ListOf(0, -1, 2, -3), convert, ConvertFailFast)
+	require.ErrorIs(test, err, errNegative)
+	require.Equal(test, "index 1: negative", err.Error())
+	require.Nil(test, results)
+	results, err = ConvertList(ListOf(0, -1, 2, -3), convert, ConvertCollectErrors)
+	require.Equal(test, "index 1: negative\nindex 3: negative", err.Error())
+	require.Nil(test, results)
+	results, err = ConvertList(ListOf(0, -1, 2, -3), convert, ConvertSkipErrors)
+	require.Equal(test, "index 1: negative\nindex 3: negative", err.Error())
+	require.True(test, results.Equal("0", "2"))
+	results, err = ConvertList(ListOf(0), convert, ConvertSkipErrors)
+	require.NoError(test, err)
+	require.True(test, results.Equal("0"))
+}
+
+func TestConvertMapValues(test *testing.T) {
+	test.Parallel()
+
+	convert := func(value int) (string, error) {
+		if value < 0 {
+			return "", errNegative
+		}
+		return fmt.Sprint(value), nil
+	}
+	results, err := ConvertMapValues(Map[string, int]{"a": 0, "b": 1}, convert, ConvertFailFast)
+	require.NoError(test, err)
+	require.True(test, results.Equal(map[string]string{"a": "0", "b": "1"}))
+	results, err = ConvertMapValues(Map[string, int]{"a": 0, "b": -1}, convert, ConvertFailFast)
+	require.ErrorIs(test, err, errNegative)
+	require.Equal(test, "key b: negative", err.Error())
+	require.Nil(test, results)
+	results, err = ConvertMapValues(Map[string, int]{"a": 0, "b": -1, "c": -2}, convert, ConvertCollectErrors)
+	require.ErrorIs(test, err, errNegative)
+	joined, _ := err.(interface{ Unwrap() []error })
+	require.Len(test, joined.Unwrap(), 2)
+	require.Nil(test, results)
+	results, err = ConvertMapValues(Map[string, int]{"a": 0, "b": -1}, convert, ConvertSkipErrors)
+	require.ErrorIs(test, err, errNegative)
+	require.True(test, results.Equal(map[string]string{"a": "0"}))
+}
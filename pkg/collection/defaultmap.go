@@ -0,0 +1,29 @@
+package collection
+
+// DefaultMap represents a map that creates the value associated with a key the
+// first time that the key is retrieved. The zero value is not usable; use
+// NewDefaultMap instead.
+type DefaultMap[Key comparable, Value any] struct {
+	Map[Key, Value]
+	factory func(key Key) (value Value)
+}
+
+// NewDefaultMap returns an empty map that uses the specified factory to create
+// the values of missing keys.
+func NewDefaultMap[Key comparable, Value any](
+	factory func(key Key) (value Value),
+) (collection *DefaultMap[Key, Value]) {
+	return &DefaultMap[Key, Value]{Map: make(Map[Key, Value]), factory: factory}
+}
+
+// Get returns the value associated with the specified key. If the map does not
+// contain the specified key, a value is created by the factory, associated
+// with the key, and returned.
+func (collection *DefaultMap[Key, Value]) Get(key Key) (current Value) {
+	current, exists := collection.Map[key]
+	if !exists {
+		current = collection.factory(key)
+		collection.Map[key] = current
+	}
+	return current
+}
@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleDefaultMap() {
+	// DefaultMap creates missing values with the factory
+	groups := NewDefaultMap(func(key int) *List[string] { return &List[string]{} })
+	for _, word := range []string{"a", "bc", "d"} {
+		groups.Get(len(word)).Add(word)
+	}
+	fmt.Println(*groups.Get(1), *groups.Get(2))
+	// Output: [a d] [bc]
+}
+
+func TestNewDefaultMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDefaultMap(func(key int) int { return key })
+	require.True(test, collection.IsEmpty())
+	collection.Put(0, 1)
+	require.True(test, collection.Equal(map[int]int{0: 1}))
+}
+
+func TestDefaultMap_Get(test *testing.T) {
+	test.Parallel()
+
+	calls := 0
+	collection := NewDefaultMap(func(key int) int {
+		calls++
+		return key * 2
+	})
+	require.Equal(test, 2, collection.Get(1))
+	require.Equal(test, 2, collection.Get(1))
+	require.Equal(test, 1, calls)
+	require.True(test, collection.ContainsKey(1))
+
+	collection.Put(2, 0)
+	require.Equal(test, 0, collection.Get(2))
+	require.Equal(test, 1, calls)
+}
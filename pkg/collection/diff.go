@@ -0,0 +1,280 @@
+package collection
+
+import "reflect"
+
+// EditKind represents the kind of change made by an edit.
+type EditKind int
+
+const (
+	// EditInsert indicates that a value was inserted.
+	EditInsert EditKind = iota
+	// EditDelete indicates that a value was deleted.
+	EditDelete
+	// EditMove indicates that a value was moved.
+	EditMove
+)
+
+// Edit represents the insertion, deletion, or move of a single value at the
+// specified position in a list. A move removes the value at the From position
+// and then inserts it at the Index position. From is zero for other edits.
+type Edit[Value any] struct {
+	Kind  EditKind
+	Index int
+	From  int
+	Value Value
+}
+
+// ListDiff represents an ordered edit script between two lists. Each edit
+// position refers to the list as modified by all of the preceding edits.
+type ListDiff[Value any] []Edit[Value]
+
+// MapDiff represents the differences between two maps. Changed contains the
+// current values of the elements whose values differ.
+type MapDiff[Key comparable, Value any] struct {
+	Added   Map[Key, Value]
+	Removed Map[Key, Value]
+	Changed Map[Key, Value]
+}
+
+// SetDiff represents the differences between two sets.
+type SetDiff[Value comparable] struct {
+	Added   Set[Value]
+	Removed Set[Value]
+}
+
+// DiffLists returns a minimal edit script that transforms the previous list
+// into the current list. Each deleted value that is also inserted is paired
+// with the insertion and reported as a single move. This function uses
+// reflection to test equality, and requires time and space proportional to
+// the product of the lengths of the lists, excluding any common prefix and
+// suffix.
+func DiffLists[Value any](previous List[Value], current List[Value]) (diff ListDiff[Value]) {
+	prefix := 0
+	for prefix < len(previous) && prefix < len(current) && reflect.DeepEqual(previous[prefix], current[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(previous)-prefix && suffix < len(current)-prefix &&
+		reflect.DeepEqual(previous[len(previous)-suffix-1], current[len(current)-suffix-1]) {
+		suffix++
+	}
+	before, after := previous[prefix:len(previous)-suffix], current[prefix:len(current)-suffix]
+	// lengths[index][jndex] is the length of the longest common subsequence of
+	// before[index:] and after[jndex:].
+	lengths := make([][]int, len(before)+1)
+	for index := range lengths {
+		lengths[index] = make([]int, len(after)+1)
+	}
+	for index := len(before) - 1; index >= 0; index-- {
+		for jndex := len(after) - 1; jndex >= 0; jndex-- {
+			if reflect.DeepEqual(before[index], after[jndex]) {
+				lengths[index][jndex] = lengths[index+1][jndex+1] + 1
+			} else if lengths[index+1][jndex] >= lengths[index][jndex+1] {
+				lengths[index][jndex] = lengths[index+1][jndex]
+			} else {
+				lengths[index][jndex] = lengths[index][jndex+1]
+			}
+		}
+	}
+	// tokens identify the value of each edit, using the position in the previous
+	// list for deletions and the length of that list plus the edit position in
+	// the script for insertions.
+	edits, tokens := make(ListDiff[Value], 0), make(List[int], 0)
+	index, jndex, position := 0, 0, prefix
+	for index < len(before) || jndex < len(after) {
+		switch {
+		case index < len(before) && jndex < len(after) && reflect.DeepEqual(before[index], after[jndex]):
+			index, jndex, position = index+1, jndex+1, position+1
+		case jndex == len(after) || (index < len(before) && lengths[index+1][jndex] >= lengths[index][jndex+1]):
+			edits = append(edits, Edit[Value]{Kind: EditDelete, Index: position, From: 0, Value: before[index]})
+			tokens = append(tokens, prefix+index)
+			index++
+		default:
+			edits = append(edits, Edit[Value]{Kind: EditInsert, Index: position, From: 0, Value: after[jndex]})
+			tokens = append(tokens, len(previous)+len(tokens))
+			jndex, position = jndex+1, position+1
+		}
+	}
+	return moveEdits(edits, tokens, len(previous))
+}
+
+// DiffMaps returns the elements that were added, removed, or changed between
+// the previous map and the current map. This function uses reflection to test
+// equality.
+func DiffMaps[Key comparable, Value any](previous Map[Key, Value], current Map[Key, Value]) (diff MapDiff[Key, Value]) {
+	diff = MapDiff[Key, Value]{
+		Added:   make(Map[Key, Value]),
+		Removed: make(Map[Key, Value]),
+		Changed: make(Map[Key, Value]),
+	}
+	for key, value := range current {
+		if old, exists := previous[key]; !exists {
+			diff.Added[key] = value
+		} else if !reflect.DeepEqual(old, value) {
+			diff.Changed[key] = value
+		}
+	}
+	for key, value := range previous {
+		if _, exists := current[key]; !exists {
+			diff.Removed[key] = value
+		}
+	}
+	return diff
+}
+
+// DiffSets returns the values that were added or removed between the previous
+// set and the current set.
+func DiffSets[Value comparable](previous Set[Value], current Set[Value]) (diff SetDiff[Value]) {
+	diff = SetDiff[Value]{
+		Added:   make(Set[Value]),
+		Removed: make(Set[Value]),
+	}
+	for value := range current {
+		if _, exists := previous[value]; !exists {
+			diff.Added[value] = struct{}{}
+		}
+	}
+	for value := range previous {
+		if _, exists := current[value]; !exists {
+			diff.Removed[value] = struct{}{}
+		}
+	}
+	return diff
+}
+
+// Apply performs the edits of the edit script on the specified list, in order.
+// If an edit position is out of range, the remaining edits are not performed.
+func (diff ListDiff[Value]) Apply(collection *List[Value]) (err error) {
+	for _, edit := range diff {
+		switch edit.Kind {
+		case EditInsert:
+			err = collection.Insert(edit.Index, edit.Value)
+		case EditMove:
+			var value Value
+			if edit.Index < 0 || edit.Index >= len(*collection) {
+				err = ErrIndexOutOfRange
+			} else if value, err = collection.Delete(edit.From); err == nil {
+				err = collection.Insert(edit.Index, value)
+			}
+		default:
+			_, err = collection.Delete(edit.Index)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if the edit script contains no edits.
+func (diff ListDiff[Value]) IsEmpty() (empty bool) {
+	return len(diff) == 0
+}
+
+// Apply adds, removes, and changes the elements of the specified map.
+func (diff MapDiff[Key, Value]) Apply(collection Map[Key, Value]) {
+	for key := range diff.Removed {
+		delete(collection, key)
+	}
+	collection.PutAll(diff.Added)
+	collection.PutAll(diff.Changed)
+}
+
+// IsEmpty returns true if the maps did not differ.
+func (diff MapDiff[Key, Value]) IsEmpty() (empty bool) {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// Apply adds and removes the values of the specified set.
+func (diff SetDiff[Value]) Apply(collection Set[Value]) {
+	for value := range diff.Removed {
+		delete(collection, value)
+	}
+	for value := range diff.Added {
+		collection[value] = struct{}{}
+	}
+}
+
+// IsEmpty returns true if the sets did not differ.
+func (diff SetDiff[Value]) IsEmpty() (empty bool) {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0
+}
+
+// moveEdits returns the specified edit script of insertions and deletions with
+// each deletion paired with an insertion of an equal value, if any, and the
+// pair replaced by a single move. The specified tokens identify the value of
+// each edit, and the specified size is the length of the previous list.
+func moveEdits[Value any](edits ListDiff[Value], tokens List[int], size int) (diff ListDiff[Value]) {
+	partners := make(List[int], len(edits))
+	for index := range partners {
+		partners[index] = -1
+	}
+	for index, edit := range edits {
+		for jndex := 0; edit.Kind == EditDelete && jndex < len(edits); jndex++ {
+			if edits[jndex].Kind == EditInsert && partners[jndex] < 0 &&
+				reflect.DeepEqual(edit.Value, edits[jndex].Value) {
+				partners[index], partners[jndex] = jndex, index
+				break
+			}
+		}
+	}
+	// script holds the tokens of the list as modified by the specified edits,
+	// and actual holds the tokens of the list as modified by the returned edits.
+	// The first edit of each pair only modifies script, inserting a placeholder
+	// for an insertion, and the second edit moves the value in actual.
+	script, actual := make(List[int], size), make(List[int], size)
+	for index := range script {
+		script[index], actual[index] = index, index
+	}
+	diff = make(ListDiff[Value], 0, len(edits))
+	for index, edit := range edits {
+		partner := partners[index]
+		switch {
+		case edit.Kind == EditInsert:
+			_ = script.Insert(edit.Index, tokens[index])
+			if partner < 0 {
+				position := editPosition(actual, script, edit.Index)
+				_ = actual.Insert(position, tokens[index])
+				diff = append(diff, Edit[Value]{Kind: EditInsert, Index: position, From: 0, Value: edit.Value})
+			} else if partner < index {
+				script[edit.Index] = tokens[partner]
+				diff = append(diff, moveEdit(&actual, script, edit.Index, tokens[partner], edit.Value))
+			}
+		case partner < 0:
+			token, _ := script.Delete(edit.Index)
+			position := actual.IndexOf(token)
+			_, _ = actual.Delete(position)
+			diff = append(diff, Edit[Value]{Kind: EditDelete, Index: position, From: 0, Value: edit.Value})
+		default:
+			_, _ = script.Delete(edit.Index)
+			if partner < index {
+				placeholder := script.IndexOf(tokens[partner])
+				script[placeholder] = tokens[index]
+				diff = append(diff, moveEdit(&actual, script, placeholder, tokens[index], edit.Value))
+			}
+		}
+	}
+	return diff
+}
+
+// moveEdit moves the specified token of the actual list to the position of
+// the specified index of the script, returning the corresponding edit.
+func moveEdit[Value any](actual *List[int], script List[int], index int, token int, value Value) (edit Edit[Value]) {
+	from := actual.IndexOf(token)
+	_, _ = actual.Delete(from)
+	position := editPosition(*actual, script, index)
+	_ = actual.Insert(position, token)
+	return Edit[Value]{Kind: EditMove, Index: position, From: from, Value: value}
+}
+
+// editPosition returns the position of the actual list that follows the
+// nearest token preceding the specified index of the script that the actual
+// list contains, or zero if there is no such token.
+func editPosition(actual List[int], script List[int], index int) (position int) {
+	for index--; index >= 0; index-- {
+		if position = actual.IndexOf(script[index]); position >= 0 {
+			return position + 1
+		}
+	}
+	return 0
+}
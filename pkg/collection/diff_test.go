@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLists(test *testing.T) {
+	test.Parallel()
+
+	previous := ListOf(0, 1, 2, 3, 4)
+	current := ListOf(0, 2, 5, 3, 4, 6)
+	diff := DiffLists(previous, current)
+	require.Equal(test, ListDiff[int]{
+		{Kind: EditDelete, Index: 1, Value: 1},
+		{Kind: EditInsert, Index: 2, Value: 5},
+		{Kind: EditInsert, Index: 5, Value: 6},
+	}, diff)
+	require.True(test, DiffLists(previous, previous).IsEmpty())
+	require.Equal(test, ListDiff[int]{
+		{Kind: EditMove, Index: 3, From: 0, Value: 0},
+	}, DiffLists(ListOf(0, 1, 2, 3), ListOf(1, 2, 3, 0)))
+	require.Equal(test, ListDiff[int]{
+		{Kind: EditMove, Index: 0, From: 3, Value: 0},
+	}, DiffLists(ListOf(1, 2, 3, 0), ListOf(0, 1, 2, 3)))
+	require.Equal(test, ListDiff[int]{
+		{Kind: EditMove, Index: 1, From: 0, Value: 0},
+		{Kind: EditInsert, Index: 2, Value: 3},
+	}, DiffLists(ListOf(0, 1, 2), ListOf(1, 0, 3, 2)))
+}
+
+func TestDiffMaps(test *testing.T) {
+	test.Parallel()
+
+	previous := Map[int, int]{0: 0, 1: 1, 2: 2}
+	current := Map[int, int]{0: 0, 1: 2, 3: 3}
+	diff := DiffMaps(previous, current)
+	require.True(test, diff.Added.Equal(map[int]int{3: 3}))
+	require.True(test, diff.Removed.Equal(map[int]int{2: 2}))
+	require.True(test, diff.Changed.Equal(map[int]int{1: 2}))
+	require.True(test, DiffMaps(previous, previous).IsEmpty())
+}
+
+func TestDiffSets(test *testing.T) {
+	test.Parallel()
+
+	diff := DiffSets(SetOf(0, 1), SetOf(1, 2))
+	require.True(test, diff.Added.Equal(2))
+	require.True(test, diff.Removed.Equal(0))
+	require.True(test, DiffSets(SetOf(0), SetOf(0)).IsEmpty())
+}
+
+func TestListDiff_Apply(test *testing.T) {
+	test.Parallel()
+
+	lists := []List[int]{{}, {0}, {0, 1, 2}, {2, 1, 0}, {1, 1, 0, 2, 2}, {3, 0, 3, 1}}
+	for _, previous := range lists {
+		for _, current := range lists {
+			collection := ListFromSlice(previous)
+			require.NoError(test, DiffLists(previous, current).Apply(&collection))
+			require.True(test, collection.Equal(current...))
+		}
+	}
+
+	random := rand.New(rand.NewSource(1))
+	for index := 0; index < 1000; index++ {
+		previous, current := make(List[int], random.Intn(8)), make(List[int], random.Intn(8))
+		for jndex := range previous {
+			previous[jndex] = random.Intn(4)
+		}
+		for jndex := range current {
+			current[jndex] = random.Intn(4)
+		}
+		collection := ListFromSlice(previous)
+		require.NoError(test, DiffLists(previous, current).Apply(&collection))
+		require.True(test, collection.Equal(current...))
+	}
+
+	collection := make(List[int], 0)
+	require.ErrorIs(test, ListDiff[int]{{Kind: EditDelete}}.Apply(&collection), ErrIndexOutOfRange)
+	collection = ListOf(0, 1)
+	require.ErrorIs(test, ListDiff[int]{{Kind: EditMove, Index: 2}}.Apply(&collection), ErrIndexOutOfRange)
+	require.ErrorIs(test, ListDiff[int]{{Kind: EditMove, From: 2}}.Apply(&collection), ErrIndexOutOfRange)
+	require.True(test, collection.Equal(0, 1))
+	require.NoError(test, ListDiff[int]{{Kind: EditMove, Index: 1}}.Apply(&collection))
+	require.True(test, collection.Equal(1, 0))
+}
+
+func TestListDiff_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, ListDiff[int]{}.IsEmpty())
+	require.False(test, ListDiff[int]{{Kind: EditInsert}}.IsEmpty())
+}
+
+func TestMapDiff_Apply(test *testing.T) {
+	test.Parallel()
+
+	previous := Map[int, int]{0: 0, 1: 1, 2: 2}
+	current := Map[int, int]{0: 0, 1: 2, 3: 3}
+	DiffMaps(previous, current).Apply(previous)
+	require.True(test, previous.Equal(current))
+}
+
+func TestMapDiff_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, MapDiff[int, int]{}.IsEmpty())
+	require.False(test, MapDiff[int, int]{Changed: Map[int, int]{0: 0}}.IsEmpty())
+}
+
+func TestSetDiff_Apply(test *testing.T) {
+	test.Parallel()
+
+	previous := SetOf(0, 1)
+	DiffSets(previous, SetOf(1, 2)).Apply(previous)
+	require.True(test, previous.Equal(1, 2))
+}
+
+func TestSetDiff_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, SetDiff[int]{}.IsEmpty())
+	require.False(test, SetDiff[int]{Added: SetOf(0)}.IsEmpty())
+}
@@ -0,0 +1,121 @@
+package collection
+
+// DisjointSet represents a collection of values partitioned into disjoint
+// groups that can be merged, also known as union-find. Find and Union run in
+// nearly constant amortized time using path compression and union by rank.
+// Find modifies the structure, so the set is not safe for concurrent use even
+// for reading. The zero value is not usable; use NewDisjointSet instead.
+type DisjointSet[Value comparable] struct {
+	parents Map[Value, Value]
+	ranks   Map[Value, int]
+	groups  int
+}
+
+// NewDisjointSet returns a disjoint set containing the specified values, each
+// in a group of its own.
+func NewDisjointSet[Value comparable](values ...Value) (collection *DisjointSet[Value]) {
+	collection = &DisjointSet[Value]{
+		parents: make(Map[Value, Value], len(values)),
+		ranks:   make(Map[Value, int], len(values)),
+		groups:  0,
+	}
+	for _, value := range values {
+		collection.Add(value)
+	}
+	return collection
+}
+
+// Add adds the specified value to the set in a group of its own, returning
+// true if the set did not already contain the value.
+func (collection *DisjointSet[Value]) Add(value Value) (modified bool) {
+	if collection.parents.ContainsKey(value) {
+		return false
+	}
+	collection.parents[value] = value
+	collection.ranks[value] = 0
+	collection.groups++
+	return true
+}
+
+// Connected returns true if the specified values are both contained in the set
+// and belong to the same group.
+func (collection *DisjointSet[Value]) Connected(this Value, that Value) (connected bool) {
+	thisRoot, thisExists := collection.Find(this)
+	thatRoot, thatExists := collection.Find(that)
+	return thisExists && thatExists && thisRoot == thatRoot
+}
+
+// Contains returns true if the set contains the specified value.
+func (collection *DisjointSet[Value]) Contains(value Value) (contains bool) {
+	return collection.parents.ContainsKey(value)
+}
+
+// Find returns the representative value of the group containing the specified
+// value and true, or the zero value and false if the set does not contain the
+// value. Values in the same group have the same representative until the
+// group is next merged.
+func (collection *DisjointSet[Value]) Find(value Value) (root Value, exists bool) {
+	if root, exists = collection.parents[value]; !exists {
+		return root, false
+	}
+	for parent := collection.parents[root]; parent != root; parent = collection.parents[root] {
+		root = parent
+	}
+	for value != root {
+		parent := collection.parents[value]
+		collection.parents[value] = root
+		value = parent
+	}
+	return root, true
+}
+
+// GroupCount returns the number of groups in the set.
+func (collection *DisjointSet[Value]) GroupCount() (count int) {
+	return collection.groups
+}
+
+// Groups returns a set of values for each group in the set, in an arbitrary
+// order.
+func (collection *DisjointSet[Value]) Groups() (groups []Set[Value]) {
+	indexes := make(Map[Value, int], collection.groups)
+	groups = make([]Set[Value], 0, collection.groups)
+	for value := range collection.parents {
+		root, _ := collection.Find(value)
+		index, exists := indexes[root]
+		if !exists {
+			index = len(groups)
+			indexes[root] = index
+			groups = append(groups, make(Set[Value]))
+		}
+		groups[index][value] = struct{}{}
+	}
+	return groups
+}
+
+// Size returns the number of values in the set.
+func (collection *DisjointSet[Value]) Size() (size int) {
+	return len(collection.parents)
+}
+
+// Union merges the groups containing the specified values, adding either value
+// to the set if it is not already contained. It returns true if the values
+// were in different groups.
+func (collection *DisjointSet[Value]) Union(this Value, that Value) (modified bool) {
+	collection.Add(this)
+	collection.Add(that)
+	thisRoot, _ := collection.Find(this)
+	thatRoot, _ := collection.Find(that)
+	if thisRoot == thatRoot {
+		return false
+	}
+	if collection.ranks[thisRoot] < collection.ranks[thatRoot] {
+		thisRoot, thatRoot = thatRoot, thisRoot
+	}
+	collection.parents[thatRoot] = thisRoot
+	if collection.ranks[thisRoot] == collection.ranks[thatRoot] {
+		collection.ranks[thisRoot]++
+	}
+	delete(collection.ranks, thatRoot)
+	collection.groups--
+	return true
+}
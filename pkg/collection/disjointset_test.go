@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisjointSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0, 1, 1, 2)
+	require.Equal(test, 3, collection.Size())
+	require.Equal(test, 3, collection.GroupCount())
+}
+
+func TestDisjointSet_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet[string]()
+	require.True(test, collection.Add("a"))
+	require.False(test, collection.Add("a"))
+	collection.Union("a", "b")
+	require.False(test, collection.Add("b"))
+	require.Equal(test, 1, collection.GroupCount())
+}
+
+func TestDisjointSet_Connected(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0, 1, 2)
+	collection.Union(0, 1)
+	require.True(test, collection.Connected(0, 1))
+	require.True(test, collection.Connected(2, 2))
+	require.False(test, collection.Connected(0, 2))
+	require.False(test, collection.Connected(3, 3))
+}
+
+func TestDisjointSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0)
+	require.True(test, collection.Contains(0))
+	require.False(test, collection.Contains(1))
+}
+
+func TestDisjointSet_Find(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet[int]()
+	for index := 1; index < 100; index++ {
+		collection.Union(index-1, index)
+	}
+	root, exists := collection.Find(99)
+	require.True(test, exists)
+	for index := 0; index < 100; index++ {
+		current, _ := collection.Find(index)
+		require.Equal(test, root, current)
+		require.Equal(test, root, collection.parents[index])
+	}
+	_, exists = collection.Find(100)
+	require.False(test, exists)
+}
+
+func TestDisjointSet_GroupCount(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0, 1, 2, 3)
+	collection.Union(0, 1)
+	collection.Union(1, 0)
+	require.Equal(test, 3, collection.GroupCount())
+}
+
+func TestDisjointSet_Groups(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0, 1, 2, 3, 4, 5)
+	collection.Union(0, 2)
+	collection.Union(4, 2)
+	collection.Union(1, 3)
+	groups := collection.Groups()
+	sort.Slice(groups, func(index int, jndex int) bool { return len(groups[index]) > len(groups[jndex]) })
+	require.Len(test, groups, 3)
+	require.True(test, groups[0].Equal(0, 2, 4))
+	require.True(test, groups[1].Equal(1, 3))
+	require.True(test, groups[2].Equal(5))
+	require.Empty(test, NewDisjointSet[int]().Groups())
+}
+
+func TestDisjointSet_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet[int]()
+	collection.Union(0, 1)
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestDisjointSet_Union(test *testing.T) {
+	test.Parallel()
+
+	collection := NewDisjointSet(0, 1, 2, 3)
+	require.True(test, collection.Union(0, 1))
+	require.True(test, collection.Union(2, 3))
+	require.True(test, collection.Union(1, 3))
+	require.False(test, collection.Union(0, 2))
+	require.True(test, collection.Connected(0, 3))
+	require.Equal(test, 1, collection.GroupCount())
+	require.Len(test, collection.ranks, 1)
+}
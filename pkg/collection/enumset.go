@@ -0,0 +1,176 @@
+package collection
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// enumWordSize is the number of values stored in each word of an enum set.
+const enumWordSize = 64
+
+// EnumSet represents a compact set of enum values in the range from zero,
+// inclusive, to the domain of the set, exclusive. Values outside the domain
+// are never contained in the set. The zero value is not usable; use
+// NewEnumSet instead.
+type EnumSet[Value ~int] struct {
+	words  []uint64
+	domain int
+}
+
+// NewEnumSet returns an enum set with the specified domain containing the
+// specified values.
+func NewEnumSet[Value ~int](domain Value, values ...Value) (collection *EnumSet[Value]) {
+	if domain < 0 {
+		domain = 0
+	}
+	size := (int(domain) + enumWordSize - 1) / enumWordSize
+	collection = &EnumSet[Value]{words: make([]uint64, size), domain: int(domain)}
+	collection.AddAll(values...)
+	return collection
+}
+
+// Add ensures that the set contains the specified value. Values outside the
+// domain of the set are ignored.
+func (collection *EnumSet[Value]) Add(value Value) (modified bool) {
+	if value < 0 || int(value) >= collection.domain {
+		return false
+	}
+	word, bit := int(value)/enumWordSize, uint64(1)<<(int(value)%enumWordSize)
+	modified = collection.words[word]&bit == 0
+	collection.words[word] |= bit
+	return modified
+}
+
+// AddAll ensures that the set contains all of the specified values. Values
+// outside the domain of the set are ignored.
+func (collection *EnumSet[Value]) AddAll(values ...Value) (modified bool) {
+	for _, value := range values {
+		modified = collection.Add(value) || modified
+	}
+	return modified
+}
+
+// Clear removes all of the values from the set.
+func (collection *EnumSet[Value]) Clear() (modified bool) {
+	for index := range collection.words {
+		modified = modified || collection.words[index] != 0
+		collection.words[index] = 0
+	}
+	return modified
+}
+
+// Clone returns a copy of the set.
+func (collection *EnumSet[Value]) Clone() (clone *EnumSet[Value]) {
+	words := append(make([]uint64, 0, len(collection.words)), collection.words...)
+	return &EnumSet[Value]{words: words, domain: collection.domain}
+}
+
+// Complement returns a set with the same domain containing all of the values
+// that the set does not contain.
+func (collection *EnumSet[Value]) Complement() (complement *EnumSet[Value]) {
+	complement = collection.Clone()
+	for index := range complement.words {
+		complement.words[index] = ^complement.words[index]
+	}
+	if remainder := complement.domain % enumWordSize; remainder > 0 {
+		complement.words[len(complement.words)-1] &= uint64(1)<<remainder - 1
+	}
+	return complement
+}
+
+// Contains returns true if the set contains the specified value.
+func (collection *EnumSet[Value]) Contains(value Value) (contains bool) {
+	if value < 0 || int(value) >= collection.domain {
+		return false
+	}
+	return collection.words[int(value)/enumWordSize]&(uint64(1)<<(int(value)%enumWordSize)) != 0
+}
+
+// Domain returns the exclusive upper bound of the values in the set.
+func (collection *EnumSet[Value]) Domain() (domain Value) {
+	return Value(collection.domain)
+}
+
+// ForEach performs the specified action for each value of the set, in
+// ascending order, until all values have been processed or the action returns
+// false.
+func (collection *EnumSet[Value]) ForEach(action func(value Value) (next bool)) {
+	for index, word := range collection.words {
+		for word != 0 {
+			offset := bits.TrailingZeros64(word)
+			if !action(Value(index*enumWordSize + offset)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Intersect returns a set containing the values contained in both sets. The
+// domain of the result is the smaller of the two domains.
+func (collection *EnumSet[Value]) Intersect(other *EnumSet[Value]) (intersection *EnumSet[Value]) {
+	if other.domain < collection.domain {
+		return other.Intersect(collection)
+	}
+	intersection = collection.Clone()
+	for index := range intersection.words {
+		intersection.words[index] &= other.words[index]
+	}
+	return intersection
+}
+
+// IsEmpty returns true if the set contains no values.
+func (collection *EnumSet[Value]) IsEmpty() (empty bool) {
+	for _, word := range collection.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove removes the specified value from the set.
+func (collection *EnumSet[Value]) Remove(value Value) (modified bool) {
+	if !collection.Contains(value) {
+		return false
+	}
+	collection.words[int(value)/enumWordSize] &^= uint64(1) << (int(value) % enumWordSize)
+	return true
+}
+
+// Size returns the number of values in the set.
+func (collection *EnumSet[Value]) Size() (size int) {
+	for _, word := range collection.words {
+		size += bits.OnesCount64(word)
+	}
+	return size
+}
+
+// Slice returns a slice containing all of the values in the set, in ascending
+// order.
+func (collection *EnumSet[Value]) Slice() (values []Value) {
+	values = make([]Value, 0, collection.Size())
+	collection.ForEach(func(value Value) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// String returns a string representation of the set.
+func (collection *EnumSet[Value]) String() (values string) {
+	return fmt.Sprint(collection.Slice())
+}
+
+// Union returns a set containing the values contained in either set. The
+// domain of the result is the larger of the two domains.
+func (collection *EnumSet[Value]) Union(other *EnumSet[Value]) (union *EnumSet[Value]) {
+	if other.domain > collection.domain {
+		return other.Union(collection)
+	}
+	union = collection.Clone()
+	for index, word := range other.words {
+		union.words[index] |= word
+	}
+	return union
+}
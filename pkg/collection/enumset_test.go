@@ -0,0 +1,145 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnumSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(100, 0, 64, 99, 100)
+	require.Equal(test, []int{0, 64, 99}, collection.Slice())
+	require.True(test, NewEnumSet(-1, 0).IsEmpty())
+}
+
+func TestEnumSet_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10)
+	require.True(test, collection.Add(1))
+	require.False(test, collection.Add(1))
+	require.False(test, collection.Add(10))
+	require.False(test, collection.Add(-1))
+}
+
+func TestEnumSet_AddAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10)
+	require.True(test, collection.AddAll(1, 2))
+	require.False(test, collection.AddAll(1, 2))
+}
+
+func TestEnumSet_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10, 1)
+	require.True(test, collection.Clear())
+	require.False(test, collection.Clear())
+}
+
+func TestEnumSet_Clone(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10, 1)
+	clone := collection.Clone()
+	require.True(test, clone.Add(2))
+	require.False(test, collection.Contains(2))
+}
+
+func TestEnumSet_Complement(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(66, 0, 65)
+	complement := collection.Complement()
+	require.Equal(test, 64, complement.Size())
+	require.False(test, complement.Contains(0))
+	require.True(test, complement.Contains(64))
+	require.False(test, complement.Contains(65))
+}
+
+func TestEnumSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10, 1)
+	require.True(test, collection.Contains(1))
+	require.False(test, collection.Contains(2))
+	require.False(test, collection.Contains(-1))
+	require.False(test, collection.Contains(10))
+}
+
+func TestEnumSet_Domain(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 10, NewEnumSet(10).Domain())
+}
+
+func TestEnumSet_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(100, 70, 3, 1)
+	values := make([]int, 0)
+	collection.ForEach(func(value int) bool {
+		values = append(values, value)
+		return value < 3
+	})
+	require.Equal(test, []int{1, 3}, values)
+}
+
+func TestEnumSet_Intersect(test *testing.T) {
+	test.Parallel()
+
+	this, that := NewEnumSet(100, 1, 2, 70), NewEnumSet(10, 2, 3)
+	intersection := this.Intersect(that)
+	require.Equal(test, []int{2}, intersection.Slice())
+	require.Equal(test, 10, intersection.Domain())
+	require.Equal(test, []int{2}, that.Intersect(this).Slice())
+}
+
+func TestEnumSet_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10)
+	require.True(test, collection.IsEmpty())
+	collection.Add(1)
+	require.False(test, collection.IsEmpty())
+}
+
+func TestEnumSet_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewEnumSet(10, 1)
+	require.True(test, collection.Remove(1))
+	require.False(test, collection.Remove(1))
+	require.False(test, collection.Remove(10))
+}
+
+func TestEnumSet_Size(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 3, NewEnumSet(100, 1, 64, 65).Size())
+}
+
+func TestEnumSet_Slice(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, []int{1, 64}, NewEnumSet(100, 64, 1).Slice())
+}
+
+func TestEnumSet_String(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, "[1 2]", NewEnumSet(10, 2, 1).String())
+}
+
+func TestEnumSet_Union(test *testing.T) {
+	test.Parallel()
+
+	this, that := NewEnumSet(10, 1), NewEnumSet(100, 70)
+	union := this.Union(that)
+	require.Equal(test, []int{1, 70}, union.Slice())
+	require.Equal(test, 100, union.Domain())
+	require.Equal(test, []int{1, 70}, that.Union(this).Slice())
+}
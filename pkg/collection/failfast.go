@@ -0,0 +1,167 @@
+package collection
+
+import "errors"
+
+// ErrConcurrentModification indicates that a collection was structurally
+// modified while it was being iterated.
+var ErrConcurrentModification = errors.New("concurrent modification")
+
+// FailFastMap represents a map that counts structural modifications, which add
+// or remove keys, so that iteration fails instead of silently skipping or
+// repeating elements when the map is modified by the action. The map is not
+// safe for concurrent use. The zero value is not usable; use NewFailFastMap
+// instead.
+type FailFastMap[Key comparable, Value any] struct {
+	elements      Map[Key, Value]
+	modifications uint64
+}
+
+// NewFailFastMap returns an empty fail-fast map.
+func NewFailFastMap[Key comparable, Value any]() (collection *FailFastMap[Key, Value]) {
+	return &FailFastMap[Key, Value]{elements: make(Map[Key, Value])}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *FailFastMap[Key, Value]) Clear() (modified bool) {
+	if modified = collection.elements.Clear(); modified {
+		collection.modifications++
+	}
+	return modified
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (collection *FailFastMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	return collection.elements.ContainsKey(key)
+}
+
+// ForEach performs the specified action for each element of the map until all
+// elements have been processed or the action returns false. If the action adds
+// or removes keys, ErrConcurrentModification is returned.
+func (collection *FailFastMap[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) (err error) {
+	expected := collection.modifications
+	for key, value := range collection.elements {
+		next := action(key, value)
+		if collection.modifications != expected {
+			return ErrConcurrentModification
+		} else if !next {
+			break
+		}
+	}
+	return nil
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (collection *FailFastMap[Key, Value]) Get(key Key) (current Value) {
+	return collection.elements.Get(key)
+}
+
+// Map returns a map containing all of the elements in the map.
+func (collection *FailFastMap[Key, Value]) Map() (elements map[Key]Value) {
+	return collection.elements.Map()
+}
+
+// Modifications returns the number of structural modifications made to the
+// map.
+func (collection *FailFastMap[Key, Value]) Modifications() (modifications uint64) {
+	return collection.modifications
+}
+
+// Put associates the specified value with the specified key in the map.
+// Replacing the value of an existing key is not a structural modification.
+func (collection *FailFastMap[Key, Value]) Put(key Key, value Value) {
+	if !collection.elements.ContainsKey(key) {
+		collection.modifications++
+	}
+	collection.elements.Put(key, value)
+}
+
+// Remove removes the specified key from the map, returning the previous value,
+// if any.
+func (collection *FailFastMap[Key, Value]) Remove(key Key) (previous Value) {
+	previous, existed := collection.elements.Pop(key)
+	if existed {
+		collection.modifications++
+	}
+	return previous
+}
+
+// Size returns the number of elements in the map.
+func (collection *FailFastMap[Key, Value]) Size() (size int) {
+	return collection.elements.Size()
+}
+
+// FailFastSet represents a set that counts structural modifications so that
+// iteration fails instead of silently skipping or repeating values when the
+// set is modified by the action. The set is not safe for concurrent use. The
+// zero value is not usable; use NewFailFastSet instead.
+type FailFastSet[Value comparable] struct {
+	values        Set[Value]
+	modifications uint64
+}
+
+// NewFailFastSet returns a fail-fast set containing the specified values.
+func NewFailFastSet[Value comparable](values ...Value) (collection *FailFastSet[Value]) {
+	return &FailFastSet[Value]{values: SetOf(values...)}
+}
+
+// Add ensures that the set contains the specified value.
+func (collection *FailFastSet[Value]) Add(value Value) (modified bool) {
+	if modified = collection.values.Add(value); modified {
+		collection.modifications++
+	}
+	return modified
+}
+
+// Clear removes all of the values from the set.
+func (collection *FailFastSet[Value]) Clear() (modified bool) {
+	if modified = collection.values.Clear(); modified {
+		collection.modifications++
+	}
+	return modified
+}
+
+// Contains returns true if the set contains the specified value.
+func (collection *FailFastSet[Value]) Contains(value Value) (contains bool) {
+	return collection.values.Contains(value)
+}
+
+// ForEach performs the specified action for each value of the set until all
+// values have been processed or the action returns false. If the action adds
+// or removes values, ErrConcurrentModification is returned.
+func (collection *FailFastSet[Value]) ForEach(action func(value Value) (next bool)) (err error) {
+	expected := collection.modifications
+	for value := range collection.values {
+		next := action(value)
+		if collection.modifications != expected {
+			return ErrConcurrentModification
+		} else if !next {
+			break
+		}
+	}
+	return nil
+}
+
+// Modifications returns the number of structural modifications made to the
+// set.
+func (collection *FailFastSet[Value]) Modifications() (modifications uint64) {
+	return collection.modifications
+}
+
+// Remove removes the specified value from the set.
+func (collection *FailFastSet[Value]) Remove(value Value) (modified bool) {
+	if modified = collection.values.Remove(value); modified {
+		collection.modifications++
+	}
+	return modified
+}
+
+// Size returns the number of values in the set.
+func (collection *FailFastSet[Value]) Size() (size int) {
+	return collection.values.Size()
+}
+
+// Slice returns a slice containing all of the values in the set.
+func (collection *FailFastSet[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
@@ -0,0 +1,202 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFailFastMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	require.Equal(test, 0, collection.Size())
+	require.Equal(test, uint64(0), collection.Modifications())
+}
+
+func TestFailFastMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	require.False(test, collection.Clear())
+	collection.Put(0, 0)
+	require.True(test, collection.Clear())
+	require.Equal(test, uint64(2), collection.Modifications())
+}
+
+func TestFailFastMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	require.True(test, collection.ContainsKey(0))
+	require.False(test, collection.ContainsKey(1))
+}
+
+func TestFailFastMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	collection.Put(1, 1)
+	require.NoError(test, collection.ForEach(func(key int, value int) bool {
+		collection.Put(key, value+1)
+		return true
+	}))
+	require.Equal(test, map[int]int{0: 1, 1: 2}, collection.Map())
+	count := 0
+	require.NoError(test, collection.ForEach(func(int, int) bool {
+		count++
+		return false
+	}))
+	require.Equal(test, 1, count)
+	require.ErrorIs(test, collection.ForEach(func(key int, value int) bool {
+		collection.Remove(key)
+		return true
+	}), ErrConcurrentModification)
+	require.ErrorIs(test, collection.ForEach(func(key int, value int) bool {
+		collection.Put(key+2, value)
+		return false
+	}), ErrConcurrentModification)
+}
+
+func TestFailFastMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.Get(0))
+	require.Equal(test, 0, collection.Get(1))
+}
+
+func TestFailFastMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	elements := collection.Map()
+	elements[1] = 1
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestFailFastMap_Modifications(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	collection.Put(0, 1)
+	require.Equal(test, uint64(1), collection.Modifications())
+}
+
+func TestFailFastMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	collection.Put(0, 1)
+	require.Equal(test, map[int]int{0: 1}, collection.Map())
+}
+
+func TestFailFastMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.Remove(0))
+	require.Equal(test, 0, collection.Remove(0))
+	require.Equal(test, uint64(2), collection.Modifications())
+}
+
+func TestFailFastMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastMap[int, int]()
+	collection.Put(0, 0)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestNewFailFastSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0, 1)
+	require.Equal(test, 2, collection.Size())
+	require.Equal(test, uint64(0), collection.Modifications())
+}
+
+func TestFailFastSet_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet[int]()
+	require.True(test, collection.Add(0))
+	require.False(test, collection.Add(0))
+	require.Equal(test, uint64(1), collection.Modifications())
+}
+
+func TestFailFastSet_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0)
+	require.True(test, collection.Clear())
+	require.False(test, collection.Clear())
+	require.Equal(test, uint64(1), collection.Modifications())
+}
+
+func TestFailFastSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0)
+	require.True(test, collection.Contains(0))
+	require.False(test, collection.Contains(1))
+}
+
+func TestFailFastSet_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0, 1)
+	sum := 0
+	require.NoError(test, collection.ForEach(func(value int) bool {
+		sum += value
+		collection.Add(value)
+		return true
+	}))
+	require.Equal(test, 1, sum)
+	require.ErrorIs(test, collection.ForEach(func(value int) bool {
+		collection.Add(value + 2)
+		return true
+	}), ErrConcurrentModification)
+	require.ErrorIs(test, collection.ForEach(func(value int) bool {
+		collection.Clear()
+		return true
+	}), ErrConcurrentModification)
+}
+
+func TestFailFastSet_Modifications(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet[int]()
+	collection.Add(0)
+	collection.Remove(0)
+	require.Equal(test, uint64(2), collection.Modifications())
+}
+
+func TestFailFastSet_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0)
+	require.True(test, collection.Remove(0))
+	require.False(test, collection.Remove(0))
+}
+
+func TestFailFastSet_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0, 1)
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestFailFastSet_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewFailFastSet(0)
+	require.Equal(test, []int{0}, collection.Slice())
+}
@@ -0,0 +1,54 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// formatCollection implements fmt.Formatter for the specified collection. The
+// %#v verb prints the underlying value of the collection as Go syntax, the
+// verbs normally handled by fmt.Stringer print the string representation of
+// the specified elements, and all other verbs print the specified elements
+// directly.
+func formatCollection(state fmt.State, verb rune, collection any, underlying any, elements any) {
+	switch {
+	case verb == 'v' && state.Flag('#'):
+		syntax := fmt.Sprintf("%#v", underlying)
+		syntax = strings.TrimPrefix(syntax, fmt.Sprintf("%T", underlying))
+		fmt.Fprintf(state, "%T%s", collection, syntax)
+	case verb == 'v' && state.Flag('+'):
+		fmt.Fprintf(state, fmt.FormatString(state, verb), elements)
+	case verb == 'v' || verb == 's' || verb == 'q' || verb == 'x' || verb == 'X':
+		fmt.Fprintf(state, fmt.FormatString(state, verb), fmt.Sprint(elements))
+	default:
+		fmt.Fprintf(state, fmt.FormatString(state, verb), elements)
+	}
+}
+
+// numericKind returns true if the specified kind is an integer or floating
+// point kind.
+func numericKind(kind reflect.Kind) (number bool) {
+	return reflect.Int <= kind && kind <= reflect.Float64
+}
+
+// sortValues sorts the specified values by value for numbers and strings, and
+// by string representation otherwise.
+func sortValues(values []reflect.Value) {
+	sort.Slice(values, func(index, jndex int) bool {
+		this, that := values[index], values[jndex]
+		switch this.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return this.Int() < that.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return this.Uint() < that.Uint()
+		case reflect.Float32, reflect.Float64:
+			return this.Float() < that.Float()
+		case reflect.String:
+			return this.String() < that.String()
+		default:
+			return fmt.Sprint(this.Interface()) < fmt.Sprint(that.Interface())
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package collection
+
+// gapBufferMinimum is the smallest capacity allocated by a gap buffer.
+const gapBufferMinimum = 16
+
+// GapBuffer represents an ordered collection of values stored with a gap at
+// the position of the most recent edit. Insertions and deletions near the
+// previous edit only move the values between the two positions, which makes
+// editor-style workloads that edit one region at a time much cheaper than the
+// equivalent List operations. The zero value is an empty buffer.
+type GapBuffer[Value any] struct {
+	buffer   []Value
+	gapStart int
+	gapEnd   int
+}
+
+// NewGapBuffer returns a gap buffer containing the specified values, with the
+// gap at the end.
+func NewGapBuffer[Value any](values ...Value) (collection *GapBuffer[Value]) {
+	collection = &GapBuffer[Value]{buffer: nil, gapStart: 0, gapEnd: 0}
+	_ = collection.InsertAll(0, values...)
+	return collection
+}
+
+// Add adds the specified value to the end of the buffer.
+func (collection *GapBuffer[Value]) Add(value Value) {
+	_ = collection.InsertAll(collection.Size(), value)
+}
+
+// Clear removes all of the values from the buffer.
+func (collection *GapBuffer[Value]) Clear() (modified bool) {
+	modified = collection.Size() > 0
+	collection.buffer = nil
+	collection.gapStart = 0
+	collection.gapEnd = 0
+	return modified
+}
+
+// Delete removes the value at the specified position in the buffer, returning
+// the previous value.
+func (collection *GapBuffer[Value]) Delete(index int) (previous Value, err error) {
+	removed, err := collection.DeleteRange(index, index+1)
+	if err != nil {
+		return previous, err
+	}
+	return removed[0], nil
+}
+
+// DeleteRange removes the values from the first position, inclusive, to the
+// second position, exclusive, returning the previous values.
+func (collection *GapBuffer[Value]) DeleteRange(from int, to int) (removed []Value, err error) {
+	if from < 0 || from > to || to > collection.Size() {
+		return nil, ErrIndexOutOfRange
+	}
+	collection.moveGap(from)
+	removed = append(make([]Value, 0, to-from), collection.buffer[collection.gapEnd:collection.gapEnd+to-from]...)
+	var empty Value
+	for index := collection.gapEnd; index < collection.gapEnd+to-from; index++ {
+		collection.buffer[index] = empty
+	}
+	collection.gapEnd += to - from
+	return removed, nil
+}
+
+// ForEach performs the specified action for each value of the buffer, in
+// order, until all values have been processed or the action returns false.
+func (collection *GapBuffer[Value]) ForEach(action func(value Value) (next bool)) {
+	for _, value := range collection.buffer[:collection.gapStart] {
+		if !action(value) {
+			return
+		}
+	}
+	for _, value := range collection.buffer[collection.gapEnd:] {
+		if !action(value) {
+			return
+		}
+	}
+}
+
+// Get returns the value at the specified position in the buffer.
+func (collection *GapBuffer[Value]) Get(index int) (current Value, err error) {
+	if index < 0 || index >= collection.Size() {
+		return current, ErrIndexOutOfRange
+	}
+	return collection.buffer[collection.physical(index)], nil
+}
+
+// Insert adds the specified value to the buffer at the specified position.
+func (collection *GapBuffer[Value]) Insert(index int, value Value) (err error) {
+	return collection.InsertAll(index, value)
+}
+
+// InsertAll adds all of the specified values to the buffer at the specified
+// position.
+func (collection *GapBuffer[Value]) InsertAll(index int, values ...Value) (err error) {
+	if index < 0 || index > collection.Size() {
+		return ErrIndexOutOfRange
+	}
+	collection.grow(len(values))
+	collection.moveGap(index)
+	collection.gapStart += copy(collection.buffer[collection.gapStart:], values)
+	return nil
+}
+
+// IsEmpty returns true if the buffer contains no values.
+func (collection *GapBuffer[Value]) IsEmpty() (empty bool) {
+	return collection.Size() == 0
+}
+
+// Set replaces the value at the specified position in the buffer with the
+// specified value.
+func (collection *GapBuffer[Value]) Set(index int, value Value) (err error) {
+	if index < 0 || index >= collection.Size() {
+		return ErrIndexOutOfRange
+	}
+	collection.buffer[collection.physical(index)] = value
+	return nil
+}
+
+// Size returns the number of values in the buffer.
+func (collection *GapBuffer[Value]) Size() (size int) {
+	return len(collection.buffer) - (collection.gapEnd - collection.gapStart)
+}
+
+// Slice returns a slice containing all of the values in the buffer, in order.
+func (collection *GapBuffer[Value]) Slice() (values []Value) {
+	values = make([]Value, 0, collection.Size())
+	values = append(values, collection.buffer[:collection.gapStart]...)
+	return append(values, collection.buffer[collection.gapEnd:]...)
+}
+
+// ToList returns a list containing all of the values in the buffer, in order.
+func (collection *GapBuffer[Value]) ToList() (values List[Value]) {
+	return collection.Slice()
+}
+
+// grow ensures that the gap can hold at least the specified number of values.
+func (collection *GapBuffer[Value]) grow(count int) {
+	if collection.gapEnd-collection.gapStart >= count {
+		return
+	}
+	capacity := 2 * len(collection.buffer)
+	if capacity < collection.Size()+count {
+		capacity = collection.Size() + count
+	}
+	if capacity < gapBufferMinimum {
+		capacity = gapBufferMinimum
+	}
+	buffer := make([]Value, capacity)
+	copy(buffer, collection.buffer[:collection.gapStart])
+	tail := len(collection.buffer) - collection.gapEnd
+	copy(buffer[capacity-tail:], collection.buffer[collection.gapEnd:])
+	collection.buffer = buffer
+	collection.gapEnd = capacity - tail
+}
+
+// moveGap moves the gap to the specified logical position, clearing the
+// positions vacated by moved values.
+func (collection *GapBuffer[Value]) moveGap(index int) {
+	var from, to int
+	if index < collection.gapStart {
+		count := collection.gapStart - index
+		copy(collection.buffer[collection.gapEnd-count:collection.gapEnd], collection.buffer[index:collection.gapStart])
+		from, to = index, collection.gapStart
+		collection.gapStart -= count
+		collection.gapEnd -= count
+		if to > collection.gapEnd {
+			to = collection.gapEnd
+		}
+	} else if index > collection.gapStart {
+		count := index - collection.gapStart
+		copy(collection.buffer[collection.gapStart:], collection.buffer[collection.gapEnd:collection.gapEnd+count])
+		from, to = collection.gapEnd, collection.gapEnd+count
+		collection.gapStart += count
+		collection.gapEnd += count
+		if from < collection.gapStart {
+			from = collection.gapStart
+		}
+	}
+	var empty Value
+	for jndex := from; jndex < to; jndex++ {
+		collection.buffer[jndex] = empty
+	}
+}
+
+// physical returns the position in the underlying buffer of the specified
+// logical position.
+func (collection *GapBuffer[Value]) physical(index int) (position int) {
+	if index < collection.gapStart {
+		return index
+	}
+	return index + collection.gapEnd - collection.gapStart
+}
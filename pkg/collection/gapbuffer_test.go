@@ -0,0 +1,173 @@
+package collection
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGapBuffer(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1, 2)
+	require.Equal(test, []int{0, 1, 2}, collection.Slice())
+	var empty GapBuffer[int]
+	require.True(test, empty.IsEmpty())
+	empty.Add(0)
+	require.Equal(test, []int{0}, empty.Slice())
+}
+
+func TestGapBuffer_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer[int]()
+	for index := 0; index < 40; index++ {
+		collection.Add(index)
+	}
+	require.Equal(test, []int(RangeList(0, 40, 1)), collection.Slice())
+}
+
+func TestGapBuffer_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1)
+	require.True(test, collection.Clear())
+	require.True(test, collection.IsEmpty())
+	require.False(test, collection.Clear())
+}
+
+func TestGapBuffer_Delete(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1, 2)
+	previous, err := collection.Delete(1)
+	require.NoError(test, err)
+	require.Equal(test, 1, previous)
+	require.Equal(test, []int{0, 2}, collection.Slice())
+	_, err = collection.Delete(2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_DeleteRange(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1, 2, 3, 4)
+	removed, err := collection.DeleteRange(1, 3)
+	require.NoError(test, err)
+	require.Equal(test, []int{1, 2}, removed)
+	require.Equal(test, []int{0, 3, 4}, collection.Slice())
+	_, err = collection.DeleteRange(2, 1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.DeleteRange(0, 4)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1, 3)
+	require.NoError(test, collection.Insert(2, 2))
+	values := make([]int, 0)
+	collection.ForEach(func(value int) bool {
+		values = append(values, value)
+		return value < 2
+	})
+	require.Equal(test, []int{0, 1, 2}, values)
+}
+
+func TestGapBuffer_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 2)
+	require.NoError(test, collection.Insert(1, 1))
+	for index := 0; index < 3; index++ {
+		value, err := collection.Get(index)
+		require.NoError(test, err)
+		require.Equal(test, index, value)
+	}
+	_, err := collection.Get(3)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.Get(-1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_Insert(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 2)
+	require.NoError(test, collection.Insert(1, 1))
+	require.NoError(test, collection.Insert(0, -1))
+	require.Equal(test, []int{-1, 0, 1, 2}, collection.Slice())
+	require.ErrorIs(test, collection.Insert(5, 0), ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_InsertAll(test *testing.T) {
+	test.Parallel()
+
+	random := rand.New(rand.NewSource(0))
+	collection := NewGapBuffer[int]()
+	var expected List[int]
+	for step := 0; step < 500; step++ {
+		index := random.Intn(expected.Size() + 1)
+		if expected.Size() > 0 && random.Intn(3) == 0 {
+			to := index + random.Intn(expected.Size()-index+1)
+			removed, err := collection.DeleteRange(index, to)
+			require.NoError(test, err)
+			previous, err := expected.DeleteRange(index, to)
+			require.NoError(test, err)
+			require.Equal(test, previous, removed)
+		} else {
+			values := RangeList(step*10, step*10+random.Intn(20), 1)
+			require.NoError(test, collection.InsertAll(index, values...))
+			require.NoError(test, expected.InsertAll(index, values...))
+		}
+		require.Equal(test, []int(expected), collection.Slice())
+	}
+	require.ErrorIs(test, collection.InsertAll(-1, 0), ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer[int]()
+	require.True(test, collection.IsEmpty())
+	collection.Add(0)
+	require.False(test, collection.IsEmpty())
+}
+
+func TestGapBuffer_Set(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 2)
+	require.NoError(test, collection.Insert(1, 1))
+	require.NoError(test, collection.Set(2, 3))
+	require.Equal(test, []int{0, 1, 3}, collection.Slice())
+	require.ErrorIs(test, collection.Set(3, 0), ErrIndexOutOfRange)
+}
+
+func TestGapBuffer_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1)
+	require.Equal(test, 2, collection.Size())
+	_, err := collection.Delete(0)
+	require.NoError(test, err)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestGapBuffer_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0)
+	values := collection.Slice()
+	values[0] = 1
+	require.Equal(test, []int{0}, collection.Slice())
+}
+
+func TestGapBuffer_ToList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewGapBuffer(0, 1)
+	require.True(test, collection.ToList().Equal(0, 1))
+}
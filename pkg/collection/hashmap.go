@@ -0,0 +1,146 @@
+package collection
+
+// HashMap represents a map that uses the specified hash and equality functions
+// instead of the built-in equality of comparable keys, which allows keys such
+// as slices, maps, and structs containing them. The zero value is not usable;
+// use NewHashMap instead.
+type HashMap[Key any, Value any] struct {
+	buckets map[uint64][]hashEntry[Key, Value]
+	hash    func(key Key) (hash uint64)
+	equal   func(this Key, that Key) (equal bool)
+	size    int
+}
+
+// hashEntry represents a key-value pair stored in a hash map bucket.
+type hashEntry[Key any, Value any] struct {
+	key   Key
+	value Value
+}
+
+// NewHashMap returns an empty hash map with the specified hash and equality
+// functions. Keys that are equal must have the same hash.
+func NewHashMap[Key any, Value any](
+	hash func(key Key) (hash uint64), equal func(this Key, that Key) (equal bool),
+) (collection *HashMap[Key, Value]) {
+	return &HashMap[Key, Value]{buckets: make(map[uint64][]hashEntry[Key, Value]), hash: hash, equal: equal, size: 0}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *HashMap[Key, Value]) Clear() (modified bool) {
+	modified = collection.size > 0
+	collection.buckets = make(map[uint64][]hashEntry[Key, Value])
+	collection.size = 0
+	return modified
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (collection *HashMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	_, index := collection.lookup(key)
+	return index >= 0
+}
+
+// ForEach performs the specified action for each element of the map until all
+// elements have been processed or the action returns false.
+func (collection *HashMap[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) {
+	for _, bucket := range collection.buckets {
+		for _, element := range bucket {
+			if !action(element.key, element.value) {
+				return
+			}
+		}
+	}
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (collection *HashMap[Key, Value]) Get(key Key) (current Value) {
+	return collection.GetOrDefault(key, current)
+}
+
+// GetOrDefault returns the value associated with the specified key, or the
+// specified value if the map does not contain the specified key.
+func (collection *HashMap[Key, Value]) GetOrDefault(key Key, value Value) (current Value) {
+	hash, index := collection.lookup(key)
+	if index < 0 {
+		return value
+	}
+	return collection.buckets[hash][index].value
+}
+
+// IsEmpty returns true if the map contains no elements.
+func (collection *HashMap[Key, Value]) IsEmpty() (empty bool) {
+	return collection.size == 0
+}
+
+// Keys returns the keys contained in the map.
+func (collection *HashMap[Key, Value]) Keys() (keys []Key) {
+	keys = make([]Key, 0, collection.size)
+	collection.ForEach(func(key Key, _ Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Put associates the specified value with the specified key, returning the
+// previous value, if any.
+func (collection *HashMap[Key, Value]) Put(key Key, value Value) (previous Value) {
+	hash, index := collection.lookup(key)
+	if index < 0 {
+		collection.buckets[hash] = append(collection.buckets[hash], hashEntry[Key, Value]{key: key, value: value})
+		collection.size++
+		return previous
+	}
+	previous = collection.buckets[hash][index].value
+	collection.buckets[hash][index].value = value
+	return previous
+}
+
+// Remove removes the specified key from the map, returning the previous value,
+// if any.
+func (collection *HashMap[Key, Value]) Remove(key Key) (previous Value) {
+	hash, index := collection.lookup(key)
+	if index < 0 {
+		return previous
+	}
+	bucket := collection.buckets[hash]
+	previous = bucket[index].value
+	last := len(bucket) - 1
+	var empty hashEntry[Key, Value]
+	bucket[index] = bucket[last]
+	bucket[last] = empty
+	if last == 0 {
+		delete(collection.buckets, hash)
+	} else {
+		collection.buckets[hash] = bucket[:last]
+	}
+	collection.size--
+	return previous
+}
+
+// Size returns the number of elements in the map.
+func (collection *HashMap[Key, Value]) Size() (size int) {
+	return collection.size
+}
+
+// Values returns the values contained in the map.
+func (collection *HashMap[Key, Value]) Values() (values []Value) {
+	values = make([]Value, 0, collection.size)
+	collection.ForEach(func(_ Key, value Value) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// lookup returns the hash of the specified key and its position within the
+// bucket for that hash, or -1 if the map does not contain the key.
+func (collection *HashMap[Key, Value]) lookup(key Key) (hash uint64, index int) {
+	hash = collection.hash(key)
+	for index, element := range collection.buckets[hash] {
+		if collection.equal(element.key, key) {
+			return hash, index
+		}
+	}
+	return hash, -1
+}
@@ -0,0 +1,144 @@
+package collection
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// hashInts returns a hash of the specified values that collides frequently, to
+// exercise buckets containing more than one key.
+func hashInts(values []int) uint64 {
+	return uint64(len(values))
+}
+
+func equalInts(this []int, that []int) bool {
+	return reflect.DeepEqual(this, that)
+}
+
+func TestNewHashMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestHashMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	require.False(test, collection.Clear())
+	collection.Put([]int{0}, 0)
+	require.True(test, collection.Clear())
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestHashMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 0)
+	require.True(test, collection.ContainsKey([]int{0}))
+	require.False(test, collection.ContainsKey([]int{1}))
+}
+
+func TestHashMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 0)
+	collection.Put([]int{1}, 1)
+	sum := 0
+	collection.ForEach(func(key []int, value int) bool {
+		sum += key[0] + value
+		return true
+	})
+	require.Equal(test, 2, sum)
+	count := 0
+	collection.ForEach(func(_ []int, _ int) bool {
+		count++
+		return false
+	})
+	require.Equal(test, 1, count)
+}
+
+func TestHashMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 1)
+	require.Equal(test, 1, collection.Get([]int{0}))
+	require.Equal(test, 0, collection.Get([]int{1}))
+}
+
+func TestHashMap_GetOrDefault(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 1)
+	require.Equal(test, 1, collection.GetOrDefault([]int{0}, 2))
+	require.Equal(test, 2, collection.GetOrDefault([]int{1}, 2))
+}
+
+func TestHashMap_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	require.True(test, collection.IsEmpty())
+	collection.Put([]int{0}, 0)
+	require.False(test, collection.IsEmpty())
+}
+
+func TestHashMap_Keys(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0, 1}, 0)
+	require.Equal(test, [][]int{{0, 1}}, collection.Keys())
+}
+
+func TestHashMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	require.Equal(test, 0, collection.Put([]int{0}, 1))
+	require.Equal(test, 0, collection.Put([]int{1}, 2))
+	require.Equal(test, 1, collection.Put([]int{0}, 3))
+	require.Equal(test, 2, collection.Size())
+	require.Equal(test, 3, collection.Get([]int{0}))
+}
+
+func TestHashMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 1)
+	collection.Put([]int{1}, 2)
+	require.Equal(test, 1, collection.Remove([]int{0}))
+	require.Equal(test, 0, collection.Remove([]int{0}))
+	require.Equal(test, 2, collection.Get([]int{1}))
+	require.Equal(test, 2, collection.Remove([]int{1}))
+	require.True(test, collection.IsEmpty())
+}
+
+func TestHashMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 0)
+	collection.Put([]int{0}, 1)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestHashMap_Values(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashMap[[]int, int](hashInts, equalInts)
+	collection.Put([]int{0}, 0)
+	collection.Put([]int{1}, 1)
+	values := collection.Values()
+	sort.Ints(values)
+	require.Equal(test, []int{0, 1}, values)
+}
@@ -0,0 +1,90 @@
+package collection
+
+// HashSet represents a set that uses the specified hash and equality functions
+// instead of the built-in equality of comparable values, which allows values
+// such as slices, maps, and structs containing them. The zero value is not
+// usable; use NewHashSet instead.
+type HashSet[Value any] struct {
+	elements *HashMap[Value, struct{}]
+}
+
+// NewHashSet returns an empty hash set with the specified hash and equality
+// functions. Values that are equal must have the same hash.
+func NewHashSet[Value any](
+	hash func(value Value) (hash uint64), equal func(this Value, that Value) (equal bool),
+) (collection *HashSet[Value]) {
+	return &HashSet[Value]{elements: NewHashMap[Value, struct{}](hash, equal)}
+}
+
+// Add ensures that the set contains the specified value.
+func (collection *HashSet[Value]) Add(value Value) (modified bool) {
+	modified = !collection.elements.ContainsKey(value)
+	collection.elements.Put(value, struct{}{})
+	return modified
+}
+
+// AddAll ensures that the set contains all of the specified values.
+func (collection *HashSet[Value]) AddAll(values ...Value) (modified bool) {
+	for _, value := range values {
+		modified = collection.Add(value) || modified
+	}
+	return modified
+}
+
+// Clear removes all of the values from the set.
+func (collection *HashSet[Value]) Clear() (modified bool) {
+	return collection.elements.Clear()
+}
+
+// Contains returns true if the set contains the specified value.
+func (collection *HashSet[Value]) Contains(value Value) (contains bool) {
+	return collection.elements.ContainsKey(value)
+}
+
+// ContainsAll returns true if the set contains all of the specified values.
+func (collection *HashSet[Value]) ContainsAll(values ...Value) (contains bool) {
+	for _, value := range values {
+		if !collection.elements.ContainsKey(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach performs the specified action for each value of the set until all
+// values have been processed or the action returns false.
+func (collection *HashSet[Value]) ForEach(action func(value Value) (next bool)) {
+	collection.elements.ForEach(func(value Value, _ struct{}) bool {
+		return action(value)
+	})
+}
+
+// IsEmpty returns true if the set contains no values.
+func (collection *HashSet[Value]) IsEmpty() (empty bool) {
+	return collection.elements.IsEmpty()
+}
+
+// Remove removes the specified value from the set.
+func (collection *HashSet[Value]) Remove(value Value) (modified bool) {
+	modified = collection.elements.ContainsKey(value)
+	collection.elements.Remove(value)
+	return modified
+}
+
+// RemoveAll removes all of the specified values from the set.
+func (collection *HashSet[Value]) RemoveAll(values ...Value) (modified bool) {
+	for _, value := range values {
+		modified = collection.Remove(value) || modified
+	}
+	return modified
+}
+
+// Size returns the number of values in the set.
+func (collection *HashSet[Value]) Size() (size int) {
+	return collection.elements.Size()
+}
+
+// Slice returns a slice containing all of the values in the set.
+func (collection *HashSet[Value]) Slice() (values []Value) {
+	return collection.elements.Keys()
+}
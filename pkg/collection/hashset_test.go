@@ -0,0 +1,116 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestHashSet_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	require.True(test, collection.Add([]int{0}))
+	require.False(test, collection.Add([]int{0}))
+	require.True(test, collection.Add([]int{1}))
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestHashSet_AddAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	require.True(test, collection.AddAll([]int{0}, []int{1}))
+	require.False(test, collection.AddAll([]int{0}, []int{1}))
+}
+
+func TestHashSet_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	require.False(test, collection.Clear())
+	collection.Add([]int{0})
+	require.True(test, collection.Clear())
+}
+
+func TestHashSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.Add([]int{0})
+	require.True(test, collection.Contains([]int{0}))
+	require.False(test, collection.Contains([]int{1}))
+}
+
+func TestHashSet_ContainsAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.AddAll([]int{0}, []int{1})
+	require.True(test, collection.ContainsAll([]int{0}, []int{1}))
+	require.False(test, collection.ContainsAll([]int{0}, []int{2}))
+}
+
+func TestHashSet_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.Add([]int{0, 1})
+	values := make([][]int, 0)
+	collection.ForEach(func(value []int) bool {
+		values = append(values, value)
+		return true
+	})
+	require.Equal(test, [][]int{{0, 1}}, values)
+}
+
+func TestHashSet_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	require.True(test, collection.IsEmpty())
+	collection.Add([]int{0})
+	require.False(test, collection.IsEmpty())
+}
+
+func TestHashSet_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.Add([]int{0})
+	require.True(test, collection.Remove([]int{0}))
+	require.False(test, collection.Remove([]int{0}))
+}
+
+func TestHashSet_RemoveAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.AddAll([]int{0}, []int{1})
+	require.True(test, collection.RemoveAll([]int{0}, []int{2}))
+	require.False(test, collection.RemoveAll([]int{0}, []int{2}))
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestHashSet_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.AddAll([]int{0}, []int{0}, []int{1})
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestHashSet_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHashSet(hashInts, equalInts)
+	collection.Add([]int{0, 1})
+	require.Equal(test, [][]int{{0, 1}}, collection.Slice())
+}
@@ -0,0 +1,156 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+const (
+	// minPrecision is the smallest supported HyperLogLog precision.
+	minPrecision = 4
+	// maxPrecision is the largest supported HyperLogLog precision.
+	maxPrecision = 16
+)
+
+// ErrPrecisionMismatch indicates that two sketches had different precisions.
+var ErrPrecisionMismatch = errors.New("precision mismatch")
+
+// HyperLogLog represents a sketch that estimates the number of distinct values
+// added to it using a fixed amount of memory. A sketch with precision p uses
+// 2^p registers and has a standard error of about 1.04/sqrt(2^p). The
+// specified hash function is mixed before use, so a simple hash is sufficient.
+// The sketch is not safe for concurrent use. The zero value is not usable; use
+// NewHyperLogLog instead.
+type HyperLogLog[Value comparable] struct {
+	registers []uint8
+	precision int
+	hash      func(value Value) (hash uint64)
+}
+
+// NewHyperLogLog returns an empty sketch with the specified precision and hash
+// function. The precision is clamped between 4 and 16.
+func NewHyperLogLog[Value comparable](
+	precision int, hash func(value Value) (hash uint64),
+) (collection *HyperLogLog[Value]) {
+	if precision < minPrecision {
+		precision = minPrecision
+	} else if precision > maxPrecision {
+		precision = maxPrecision
+	}
+	return &HyperLogLog[Value]{registers: make([]uint8, 1<<precision), precision: precision, hash: hash}
+}
+
+// Add records the specified values in the sketch.
+func (collection *HyperLogLog[Value]) Add(values ...Value) {
+	for _, value := range values {
+		hash := mixHash(collection.hash(value))
+		index := hash >> (64 - collection.precision)
+		rank := uint8(bits.LeadingZeros64(hash<<collection.precision|1<<(collection.precision-1))) + 1
+		if rank > collection.registers[index] {
+			collection.registers[index] = rank
+		}
+	}
+}
+
+// Clear resets the sketch to its empty state.
+func (collection *HyperLogLog[Value]) Clear() {
+	for index := range collection.registers {
+		collection.registers[index] = 0
+	}
+}
+
+// EstimateCardinality returns the estimated number of distinct values added to
+// the sketch.
+func (collection *HyperLogLog[Value]) EstimateCardinality() (estimate uint64) {
+	size := float64(len(collection.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range collection.registers {
+		sum += math.Ldexp(1, -int(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+	result := hyperLogLogAlpha(len(collection.registers)) * size * size / sum
+	if result <= 2.5*size && zeros > 0 {
+		result = size * math.Log(size/float64(zeros))
+	}
+	return uint64(math.Round(result))
+}
+
+// MarshalBinary returns a byte representation of the registers of the sketch.
+func (collection *HyperLogLog[Value]) MarshalBinary() (data []byte, err error) {
+	data = appendBinaryHeader(make([]byte, 0, len(collection.registers)+4), len(collection.registers))
+	return append(data, collection.registers...), nil
+}
+
+// Merge combines the specified sketch into the sketch, so that it estimates
+// the number of distinct values added to either. Both sketches must have the
+// same precision and hash function.
+func (collection *HyperLogLog[Value]) Merge(other *HyperLogLog[Value]) (err error) {
+	if collection.precision != other.precision {
+		return fmt.Errorf("%w: %d and %d", ErrPrecisionMismatch, collection.precision, other.precision)
+	}
+	for index, register := range other.registers {
+		if register > collection.registers[index] {
+			collection.registers[index] = register
+		}
+	}
+	return nil
+}
+
+// Precision returns the precision of the sketch.
+func (collection *HyperLogLog[Value]) Precision() (precision int) {
+	return collection.precision
+}
+
+// UnmarshalBinary replaces the registers of the sketch with the specified byte
+// representation, adopting its precision. The hash function is retained, so
+// the data must have been produced by a sketch with the same hash function.
+func (collection *HyperLogLog[Value]) UnmarshalBinary(data []byte) (err error) {
+	reader := bytes.NewReader(data)
+	size, _, err := readBinaryHeader(reader)
+	if err != nil {
+		return err
+	} else if size != reader.Len() {
+		return io.ErrUnexpectedEOF
+	}
+	precision := bits.TrailingZeros(uint(size))
+	if size != 1<<precision || precision < minPrecision || precision > maxPrecision {
+		return fmt.Errorf("%w: %d registers", ErrPrecisionMismatch, size)
+	}
+	registers := make([]uint8, size)
+	_, _ = reader.Read(registers)
+	collection.registers = registers
+	collection.precision = precision
+	return nil
+}
+
+// hyperLogLogAlpha returns the bias correction constant for the specified
+// number of registers.
+func hyperLogLogAlpha(size int) (alpha float64) {
+	switch size {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(size))
+	}
+}
+
+// mixHash returns the specified hash with its bits thoroughly mixed, using the
+// finalizer of SplitMix64.
+func mixHash(hash uint64) (mixed uint64) {
+	hash ^= hash >> 30
+	hash *= 0xbf58476d1ce4e5b9
+	hash ^= hash >> 27
+	hash *= 0x94d049bb133111eb
+	return hash ^ hash>>31
+}
@@ -0,0 +1,97 @@
+package collection
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func hashInt(value int) uint64 {
+	return uint64(value)
+}
+
+func TestNewHyperLogLog(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 4, NewHyperLogLog(0, hashInt).Precision())
+	require.Equal(test, 16, NewHyperLogLog(20, hashInt).Precision())
+	require.Equal(test, uint64(0), NewHyperLogLog(10, hashInt).EstimateCardinality())
+}
+
+func TestHyperLogLog_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHyperLogLog(10, hashInt)
+	collection.Add(0, 1, 2)
+	collection.Add(0, 1, 2)
+	require.Equal(test, uint64(3), collection.EstimateCardinality())
+}
+
+func TestHyperLogLog_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHyperLogLog(10, hashInt)
+	collection.Add(0, 1)
+	collection.Clear()
+	require.Equal(test, uint64(0), collection.EstimateCardinality())
+}
+
+func TestHyperLogLog_EstimateCardinality(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHyperLogLog(14, hashInt)
+	for index := 0; index < 200000; index++ {
+		collection.Add(index % 100000)
+	}
+	require.InEpsilon(test, 100000, collection.EstimateCardinality(), 0.03)
+}
+
+func TestHyperLogLog_MarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHyperLogLog(4, hashInt)
+	collection.Add(0)
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+	require.Len(test, data, 18)
+}
+
+func TestHyperLogLog_Merge(test *testing.T) {
+	test.Parallel()
+
+	this := NewHyperLogLog(12, hashInt)
+	that := NewHyperLogLog(12, hashInt)
+	for index := 0; index < 1000; index++ {
+		this.Add(index)
+		that.Add(index + 500)
+	}
+	require.NoError(test, this.Merge(that))
+	require.InEpsilon(test, 1500, this.EstimateCardinality(), 0.05)
+	require.ErrorIs(test, this.Merge(NewHyperLogLog(10, hashInt)), ErrPrecisionMismatch)
+}
+
+func TestHyperLogLog_Precision(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 12, NewHyperLogLog(12, hashInt).Precision())
+}
+
+func TestHyperLogLog_UnmarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := NewHyperLogLog(8, hashInt)
+	collection.Add(RangeList(0, 100, 1)...)
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+	restored := NewHyperLogLog(4, hashInt)
+	require.NoError(test, restored.UnmarshalBinary(data))
+	require.Equal(test, 8, restored.Precision())
+	require.Equal(test, collection.EstimateCardinality(), restored.EstimateCardinality())
+
+	require.ErrorIs(test, restored.UnmarshalBinary(data[:len(data)-1]), io.ErrUnexpectedEOF)
+	require.ErrorIs(test, restored.UnmarshalBinary(append(data, 0)), io.ErrUnexpectedEOF)
+	require.ErrorIs(test, restored.UnmarshalBinary([]byte{1, 3, 0, 0, 0}), ErrPrecisionMismatch)
+	require.ErrorIs(test, restored.UnmarshalBinary([]byte{2}), ErrUnsupportedVersion)
+	require.Equal(test, 8, restored.Precision())
+}
@@ -0,0 +1,55 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// IdentityMap represents a map that compares keys by identity rather than by
+// value, so that distinct pointers to equal values are distinct keys. The zero
+// value is not usable; use NewIdentityMap instead.
+type IdentityMap[Key any, Value any] struct {
+	*HashMap[Key, Value]
+}
+
+// NewIdentityMap returns an empty map that uses the specified function to
+// determine the identity of each key. A nil function uses the address of
+// pointer, map, and channel keys, and panics with ErrUnsupportedType for keys
+// of other kinds, since slices that share an array, and closures of the same
+// function, would have the same address. For an interface key type, the kind
+// of each key is checked when it is used; otherwise, it is checked here.
+func NewIdentityMap[Key any, Value any](identity func(key Key) (id uintptr)) (collection *IdentityMap[Key, Value]) {
+	if identity == nil {
+		kind := reflect.TypeOf((*Key)(nil)).Elem()
+		if kind.Kind() != reflect.Interface && !addressKind(kind.Kind()) {
+			panic(fmt.Errorf("%w: %v", ErrUnsupportedType, kind))
+		}
+		identity = addressOf[Key]
+	}
+	hash := func(key Key) uint64 {
+		return uint64(identity(key))
+	}
+	equal := func(this Key, that Key) bool {
+		return identity(this) == identity(that)
+	}
+	return &IdentityMap[Key, Value]{HashMap: NewHashMap[Key, Value](hash, equal)}
+}
+
+// addressKind returns true if values of the specified kind are identified by
+// the address they reference.
+func addressKind(kind reflect.Kind) (address bool) {
+	return kind == reflect.Pointer || kind == reflect.Map || kind == reflect.Chan || kind == reflect.UnsafePointer
+}
+
+// addressOf returns the address referenced by the specified key, or zero if
+// the key is a nil interface. It panics with ErrUnsupportedType if the key is
+// not a pointer, map, or channel.
+func addressOf[Key any](key Key) (id uintptr) {
+	value := reflect.ValueOf(key)
+	if !value.IsValid() {
+		return 0
+	} else if !addressKind(value.Kind()) {
+		panic(fmt.Errorf("%w: %v", ErrUnsupportedType, value.Type()))
+	}
+	return value.Pointer()
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdentityMap(test *testing.T) {
+	test.Parallel()
+
+	this, that := new(int), new(int)
+	collection := NewIdentityMap[*int, string](nil)
+	collection.Put(this, "this")
+	collection.Put(that, "that")
+	require.Equal(test, 2, collection.Size())
+	require.Equal(test, "this", collection.Get(this))
+	require.Equal(test, "that", collection.Get(that))
+
+	var empty any
+	objects := NewIdentityMap[any, int](nil)
+	objects.Put(empty, 1)
+	require.Equal(test, 1, objects.Get(nil))
+
+	type node struct{ id int }
+	nodes := NewIdentityMap[node, int](func(key node) uintptr { return uintptr(key.id) })
+	nodes.Put(node{id: 1}, 1)
+	require.True(test, nodes.ContainsKey(node{id: 1}))
+	channel := make(chan int)
+	channels := NewIdentityMap[chan int, int](nil)
+	channels.Put(channel, 1)
+	require.Equal(test, 1, channels.Get(channel))
+
+	unsupported := func(action func()) {
+		defer func() {
+			err, _ := recover().(error)
+			require.ErrorIs(test, err, ErrUnsupportedType)
+		}()
+		action()
+	}
+	unsupported(func() { NewIdentityMap[int, int](nil) })
+	unsupported(func() { NewIdentityMap[[]int, int](nil) })
+	unsupported(func() { NewIdentityMap[func(), int](nil) })
+	unsupported(func() { objects.Put(0, 0) })
+	unsupported(func() { objects.Put([]int{}, 0) })
+	unsupported(func() { objects.Put(func() {}, 0) })
+}
@@ -0,0 +1,141 @@
+package collection
+
+import "sort"
+
+// IndexedList represents an ordered collection that maintains an index from
+// the key of each value, as produced by the specified key function, to the
+// positions of the values with that key, which makes lookups constant time.
+// Values with the same key are considered equal. The zero value is not usable;
+// use NewIndexedList instead.
+type IndexedList[Key comparable, Value any] struct {
+	values  List[Value]
+	indexes map[Key][]int
+	key     func(value Value) (key Key)
+}
+
+// NewIndexedList returns an indexed list containing the specified values,
+// using the specified function to compute the key of each value.
+func NewIndexedList[Key comparable, Value any](
+	key func(value Value) (key Key), values ...Value,
+) (collection *IndexedList[Key, Value]) {
+	collection = &IndexedList[Key, Value]{
+		values:  make(List[Value], 0, len(values)),
+		indexes: make(map[Key][]int, len(values)),
+		key:     key,
+	}
+	collection.Add(values...)
+	return collection
+}
+
+// Add appends the specified values to the end of the list.
+func (collection *IndexedList[Key, Value]) Add(values ...Value) {
+	for _, value := range values {
+		key := collection.key(value)
+		collection.indexes[key] = append(collection.indexes[key], len(collection.values))
+		collection.values = append(collection.values, value)
+	}
+}
+
+// Clear removes all of the values from the list.
+func (collection *IndexedList[Key, Value]) Clear() (modified bool) {
+	modified = len(collection.values) > 0
+	collection.values = collection.values[:0]
+	collection.indexes = make(map[Key][]int)
+	return modified
+}
+
+// Contains returns true if the list contains a value with the same key as the
+// specified value.
+func (collection *IndexedList[Key, Value]) Contains(value Value) (contains bool) {
+	return collection.ContainsKey(collection.key(value))
+}
+
+// ContainsKey returns true if the list contains a value with the specified
+// key.
+func (collection *IndexedList[Key, Value]) ContainsKey(key Key) (contains bool) {
+	return len(collection.indexes[key]) > 0
+}
+
+// Get returns the value at the specified position in the list.
+func (collection *IndexedList[Key, Value]) Get(index int) (current Value, err error) {
+	return collection.values.Get(index)
+}
+
+// IndexOf returns the index of the first value in the list with the same key
+// as the specified value, or -1 if the list does not contain such a value.
+func (collection *IndexedList[Key, Value]) IndexOf(value Value) (index int) {
+	if indexes := collection.indexes[collection.key(value)]; len(indexes) > 0 {
+		return indexes[0]
+	}
+	return -1
+}
+
+// IndexesOf returns the indexes of the values in the list with the specified
+// key, in ascending order.
+func (collection *IndexedList[Key, Value]) IndexesOf(key Key) (indexes []int) {
+	return append([]int(nil), collection.indexes[key]...)
+}
+
+// IsEmpty returns true if the list contains no values.
+func (collection *IndexedList[Key, Value]) IsEmpty() (empty bool) {
+	return len(collection.values) == 0
+}
+
+// Remove removes the first value in the list with the same key as the
+// specified value. Removing a value shifts the positions of subsequent values,
+// so this method takes linear time.
+func (collection *IndexedList[Key, Value]) Remove(value Value) (modified bool) {
+	index := collection.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	collection.unindex(collection.key(value), index)
+	_, _ = collection.values.Delete(index)
+	for _, indexes := range collection.indexes {
+		for jndex := sort.SearchInts(indexes, index); jndex < len(indexes); jndex++ {
+			indexes[jndex]--
+		}
+	}
+	return true
+}
+
+// Set replaces the value at the specified position in the list with the
+// specified value.
+func (collection *IndexedList[Key, Value]) Set(index int, value Value) (err error) {
+	previous, err := collection.values.Get(index)
+	if err != nil {
+		return err
+	}
+	collection.unindex(collection.key(previous), index)
+	key := collection.key(value)
+	indexes := collection.indexes[key]
+	position := sort.SearchInts(indexes, index)
+	indexes = append(indexes, 0)
+	copy(indexes[position+1:], indexes[position:])
+	indexes[position] = index
+	collection.indexes[key] = indexes
+	collection.values[index] = value
+	return nil
+}
+
+// Size returns the number of values in the list.
+func (collection *IndexedList[Key, Value]) Size() (size int) {
+	return len(collection.values)
+}
+
+// Slice returns a slice containing all of the values in the list, in order.
+func (collection *IndexedList[Key, Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// unindex removes the specified position from the index of the specified key.
+func (collection *IndexedList[Key, Value]) unindex(key Key, index int) {
+	indexes := collection.indexes[key]
+	position := sort.SearchInts(indexes, index)
+	indexes = append(indexes[:position], indexes[position+1:]...)
+	if len(indexes) == 0 {
+		delete(collection.indexes, key)
+	} else {
+		collection.indexes[key] = indexes
+	}
+}
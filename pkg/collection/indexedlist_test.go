@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndexedList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "B", "b")
+	require.Equal(test, []string{"a", "B", "b"}, collection.Slice())
+	require.Equal(test, []int{1, 2}, collection.IndexesOf("b"))
+}
+
+func TestIndexedList_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower)
+	collection.Add("a", "b")
+	collection.Add("A")
+	require.Equal(test, 3, collection.Size())
+	require.Equal(test, []int{0, 2}, collection.IndexesOf("a"))
+}
+
+func TestIndexedList_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a")
+	require.True(test, collection.Clear())
+	require.True(test, collection.IsEmpty())
+	require.False(test, collection.Contains("a"))
+	require.False(test, collection.Clear())
+}
+
+func TestIndexedList_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a")
+	require.True(test, collection.Contains("A"))
+	require.False(test, collection.Contains("b"))
+}
+
+func TestIndexedList_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "A")
+	require.True(test, collection.ContainsKey("a"))
+	require.False(test, collection.ContainsKey("A"))
+}
+
+func TestIndexedList_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a")
+	value, err := collection.Get(0)
+	require.NoError(test, err)
+	require.Equal(test, "a", value)
+	_, err = collection.Get(1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestIndexedList_IndexOf(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "b", "B")
+	require.Equal(test, 1, collection.IndexOf("B"))
+	require.Equal(test, -1, collection.IndexOf("c"))
+}
+
+func TestIndexedList_IndexesOf(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "b", "A")
+	indexes := collection.IndexesOf("a")
+	require.Equal(test, []int{0, 2}, indexes)
+	indexes[0] = 1
+	require.Equal(test, []int{0, 2}, collection.IndexesOf("a"))
+	require.Empty(test, collection.IndexesOf("c"))
+}
+
+func TestIndexedList_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower)
+	require.True(test, collection.IsEmpty())
+	collection.Add("a")
+	require.False(test, collection.IsEmpty())
+}
+
+func TestIndexedList_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "b", "c", "B", "a")
+	require.True(test, collection.Remove("B"))
+	require.Equal(test, []string{"a", "c", "B", "a"}, collection.Slice())
+	require.Equal(test, []int{0, 3}, collection.IndexesOf("a"))
+	require.Equal(test, []int{2}, collection.IndexesOf("b"))
+	require.Equal(test, 1, collection.IndexOf("c"))
+	require.True(test, collection.Remove("b"))
+	require.False(test, collection.Remove("b"))
+	require.False(test, collection.ContainsKey("b"))
+}
+
+func TestIndexedList_Set(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "b", "a")
+	require.NoError(test, collection.Set(2, "b"))
+	require.Equal(test, []int{0}, collection.IndexesOf("a"))
+	require.Equal(test, []int{1, 2}, collection.IndexesOf("b"))
+	require.NoError(test, collection.Set(1, "a"))
+	require.Equal(test, []int{0, 1}, collection.IndexesOf("a"))
+	require.Equal(test, []string{"a", "a", "b"}, collection.Slice())
+	require.ErrorIs(test, collection.Set(3, "c"), ErrIndexOutOfRange)
+}
+
+func TestIndexedList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a", "b")
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestIndexedList_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewIndexedList(strings.ToLower, "a")
+	values := collection.Slice()
+	values[0] = "b"
+	require.Equal(test, []string{"a"}, collection.Slice())
+}
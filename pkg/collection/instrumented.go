@@ -0,0 +1,245 @@
+package collection
+
+import "sync/atomic"
+
+// OperationStats represents the number of operations performed on an
+// instrumented collection. Hits and Misses count the lookups that did and did
+// not find the requested element.
+type OperationStats struct {
+	Gets     uint64
+	Hits     uint64
+	Misses   uint64
+	Puts     uint64
+	Removals uint64
+}
+
+// operationCounters counts the operations performed on an instrumented
+// collection, and is safe to read while the collection is in use.
+type operationCounters struct {
+	gets     atomic.Uint64
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+	puts     atomic.Uint64
+	removals atomic.Uint64
+}
+
+// get counts a lookup that did or did not find the requested element.
+func (counters *operationCounters) get(hit bool) {
+	counters.gets.Add(1)
+	if hit {
+		counters.hits.Add(1)
+	} else {
+		counters.misses.Add(1)
+	}
+}
+
+// stats returns a snapshot of the counters.
+func (counters *operationCounters) stats() (stats OperationStats) {
+	return OperationStats{
+		Gets:     counters.gets.Load(),
+		Hits:     counters.hits.Load(),
+		Misses:   counters.misses.Load(),
+		Puts:     counters.puts.Load(),
+		Removals: counters.removals.Load(),
+	}
+}
+
+// InstrumentedList represents a list that counts the operations performed on
+// it. The counters may be read with Stats while the list is in use, for
+// example from an expvar.Func, but the list itself is not safe for concurrent
+// use. The zero value is not usable; use NewInstrumentedList instead.
+type InstrumentedList[Value any] struct {
+	values   List[Value]
+	counters operationCounters
+}
+
+// NewInstrumentedList returns an instrumented list containing the specified
+// values.
+func NewInstrumentedList[Value any](values ...Value) (collection *InstrumentedList[Value]) {
+	return &InstrumentedList[Value]{values: ListOf(values...)}
+}
+
+// Add adds the specified value to the end of the list.
+func (collection *InstrumentedList[Value]) Add(value Value) (modified bool) {
+	collection.counters.puts.Add(1)
+	return collection.values.Add(value)
+}
+
+// Clear removes all of the values from the list.
+func (collection *InstrumentedList[Value]) Clear() (modified bool) {
+	collection.counters.removals.Add(uint64(len(collection.values)))
+	return collection.values.Clear()
+}
+
+// Delete removes the value at the specified position in the list, returning
+// the previous value.
+func (collection *InstrumentedList[Value]) Delete(index int) (previous Value, err error) {
+	if previous, err = collection.values.Delete(index); err == nil {
+		collection.counters.removals.Add(1)
+	}
+	return previous, err
+}
+
+// Get returns the value at the specified position in the list.
+func (collection *InstrumentedList[Value]) Get(index int) (current Value, err error) {
+	current, err = collection.values.Get(index)
+	collection.counters.get(err == nil)
+	return current, err
+}
+
+// Set replaces the value at the specified position in the list.
+func (collection *InstrumentedList[Value]) Set(index int, value Value) (err error) {
+	if err = collection.values.Set(index, value); err == nil {
+		collection.counters.puts.Add(1)
+	}
+	return err
+}
+
+// Size returns the number of values in the list.
+func (collection *InstrumentedList[Value]) Size() (size int) {
+	return collection.values.Size()
+}
+
+// Slice returns a slice containing all of the values in the list.
+func (collection *InstrumentedList[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// Stats returns the number of operations performed on the list.
+func (collection *InstrumentedList[Value]) Stats() (stats OperationStats) {
+	return collection.counters.stats()
+}
+
+// InstrumentedMap represents a map that counts the operations performed on
+// it. The counters may be read with Stats while the map is in use, for example
+// from an expvar.Func, but the map itself is not safe for concurrent use. The
+// zero value is not usable; use NewInstrumentedMap instead.
+type InstrumentedMap[Key comparable, Value any] struct {
+	elements Map[Key, Value]
+	counters operationCounters
+}
+
+// NewInstrumentedMap returns an empty instrumented map.
+func NewInstrumentedMap[Key comparable, Value any]() (collection *InstrumentedMap[Key, Value]) {
+	return &InstrumentedMap[Key, Value]{elements: make(Map[Key, Value])}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *InstrumentedMap[Key, Value]) Clear() (modified bool) {
+	collection.counters.removals.Add(uint64(len(collection.elements)))
+	return collection.elements.Clear()
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (collection *InstrumentedMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	contains = collection.elements.ContainsKey(key)
+	collection.counters.get(contains)
+	return contains
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (collection *InstrumentedMap[Key, Value]) Get(key Key) (current Value) {
+	current, contains := collection.elements[key]
+	collection.counters.get(contains)
+	return current
+}
+
+// GetOrDefault returns the value associated with the specified key, or the
+// specified value if the map does not contain the specified key.
+func (collection *InstrumentedMap[Key, Value]) GetOrDefault(key Key, value Value) (current Value) {
+	current, contains := collection.elements[key]
+	collection.counters.get(contains)
+	if !contains {
+		return value
+	}
+	return current
+}
+
+// Map returns a map containing all of the elements in the map.
+func (collection *InstrumentedMap[Key, Value]) Map() (elements map[Key]Value) {
+	return collection.elements.Map()
+}
+
+// Put associates the specified value with the specified key in the map.
+func (collection *InstrumentedMap[Key, Value]) Put(key Key, value Value) {
+	collection.counters.puts.Add(1)
+	collection.elements.Put(key, value)
+}
+
+// Remove removes the specified key from the map, returning the previous value,
+// if any.
+func (collection *InstrumentedMap[Key, Value]) Remove(key Key) (previous Value) {
+	previous, existed := collection.elements.Pop(key)
+	if existed {
+		collection.counters.removals.Add(1)
+	}
+	return previous
+}
+
+// Size returns the number of elements in the map.
+func (collection *InstrumentedMap[Key, Value]) Size() (size int) {
+	return collection.elements.Size()
+}
+
+// Stats returns the number of operations performed on the map.
+func (collection *InstrumentedMap[Key, Value]) Stats() (stats OperationStats) {
+	return collection.counters.stats()
+}
+
+// InstrumentedSet represents a set that counts the operations performed on
+// it. The counters may be read with Stats while the set is in use, for example
+// from an expvar.Func, but the set itself is not safe for concurrent use. The
+// zero value is not usable; use NewInstrumentedSet instead.
+type InstrumentedSet[Value comparable] struct {
+	values   Set[Value]
+	counters operationCounters
+}
+
+// NewInstrumentedSet returns an instrumented set containing the specified
+// values.
+func NewInstrumentedSet[Value comparable](values ...Value) (collection *InstrumentedSet[Value]) {
+	return &InstrumentedSet[Value]{values: SetOf(values...)}
+}
+
+// Add ensures that the set contains the specified value.
+func (collection *InstrumentedSet[Value]) Add(value Value) (modified bool) {
+	collection.counters.puts.Add(1)
+	return collection.values.Add(value)
+}
+
+// Clear removes all of the values from the set.
+func (collection *InstrumentedSet[Value]) Clear() (modified bool) {
+	collection.counters.removals.Add(uint64(len(collection.values)))
+	return collection.values.Clear()
+}
+
+// Contains returns true if the set contains the specified value.
+func (collection *InstrumentedSet[Value]) Contains(value Value) (contains bool) {
+	contains = collection.values.Contains(value)
+	collection.counters.get(contains)
+	return contains
+}
+
+// Remove removes the specified value from the set.
+func (collection *InstrumentedSet[Value]) Remove(value Value) (modified bool) {
+	if modified = collection.values.Remove(value); modified {
+		collection.counters.removals.Add(1)
+	}
+	return modified
+}
+
+// Size returns the number of values in the set.
+func (collection *InstrumentedSet[Value]) Size() (size int) {
+	return collection.values.Size()
+}
+
+// Slice returns a slice containing all of the values in the set.
+func (collection *InstrumentedSet[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// Stats returns the number of operations performed on the set.
+func (collection *InstrumentedSet[Value]) Stats() (stats OperationStats) {
+	return collection.counters.stats()
+}
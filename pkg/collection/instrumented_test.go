@@ -0,0 +1,239 @@
+package collection
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInstrumentedList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0, 1)
+	require.Equal(test, []int{0, 1}, collection.Slice())
+	require.Equal(test, OperationStats{Gets: 0, Hits: 0, Misses: 0, Puts: 0, Removals: 0}, collection.Stats())
+}
+
+func TestInstrumentedList_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList[int]()
+	require.True(test, collection.Add(0))
+	require.Equal(test, uint64(1), collection.Stats().Puts)
+}
+
+func TestInstrumentedList_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0, 1)
+	require.True(test, collection.Clear())
+	require.Equal(test, uint64(2), collection.Stats().Removals)
+}
+
+func TestInstrumentedList_Delete(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0)
+	_, err := collection.Delete(0)
+	require.NoError(test, err)
+	_, err = collection.Delete(0)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	require.Equal(test, uint64(1), collection.Stats().Removals)
+}
+
+func TestInstrumentedList_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0)
+	_, err := collection.Get(0)
+	require.NoError(test, err)
+	_, err = collection.Get(1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	require.Equal(test, OperationStats{Gets: 2, Hits: 1, Misses: 1, Puts: 0, Removals: 0}, collection.Stats())
+}
+
+func TestInstrumentedList_Set(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0)
+	require.NoError(test, collection.Set(0, 1))
+	require.ErrorIs(test, collection.Set(1, 1), ErrIndexOutOfRange)
+	require.Equal(test, uint64(1), collection.Stats().Puts)
+}
+
+func TestInstrumentedList_Size(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 2, NewInstrumentedList(0, 1).Size())
+}
+
+func TestInstrumentedList_Slice(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, []int{0, 1}, NewInstrumentedList(0, 1).Slice())
+}
+
+func TestInstrumentedList_Stats(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedList(0)
+	variable := expvar.Func(func() any { return collection.Stats() })
+	_, _ = collection.Get(0)
+	require.JSONEq(test, `{"Gets":1,"Hits":1,"Misses":0,"Puts":0,"Removals":0}`, variable.String())
+}
+
+func TestNewInstrumentedMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestInstrumentedMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 0)
+	require.True(test, collection.Clear())
+	require.Equal(test, uint64(1), collection.Stats().Removals)
+}
+
+func TestInstrumentedMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 0)
+	require.True(test, collection.ContainsKey(0))
+	require.False(test, collection.ContainsKey(1))
+	require.Equal(test, OperationStats{Gets: 2, Hits: 1, Misses: 1, Puts: 1, Removals: 0}, collection.Stats())
+}
+
+func TestInstrumentedMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.Get(0))
+	require.Equal(test, 0, collection.Get(1))
+	require.Equal(test, OperationStats{Gets: 2, Hits: 1, Misses: 1, Puts: 1, Removals: 0}, collection.Stats())
+}
+
+func TestInstrumentedMap_GetOrDefault(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.GetOrDefault(0, 2))
+	require.Equal(test, 2, collection.GetOrDefault(1, 2))
+	require.Equal(test, uint64(1), collection.Stats().Misses)
+}
+
+func TestInstrumentedMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, map[int]int{0: 1}, collection.Map())
+}
+
+func TestInstrumentedMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	collection.Put(0, 2)
+	require.Equal(test, uint64(2), collection.Stats().Puts)
+}
+
+func TestInstrumentedMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.Remove(0))
+	require.Equal(test, 0, collection.Remove(0))
+	require.Equal(test, uint64(1), collection.Stats().Removals)
+}
+
+func TestInstrumentedMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestInstrumentedMap_Stats(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedMap[int, int]()
+	collection.Put(0, 1)
+	collection.Get(0)
+	collection.Remove(0)
+	require.Equal(test, OperationStats{Gets: 1, Hits: 1, Misses: 0, Puts: 1, Removals: 1}, collection.Stats())
+}
+
+func TestNewInstrumentedSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet(0, 1)
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestInstrumentedSet_Add(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet[int]()
+	require.True(test, collection.Add(0))
+	require.False(test, collection.Add(0))
+	require.Equal(test, uint64(2), collection.Stats().Puts)
+}
+
+func TestInstrumentedSet_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet(0, 1)
+	require.True(test, collection.Clear())
+	require.Equal(test, uint64(2), collection.Stats().Removals)
+}
+
+func TestInstrumentedSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet(0)
+	require.True(test, collection.Contains(0))
+	require.False(test, collection.Contains(1))
+	require.Equal(test, OperationStats{Gets: 2, Hits: 1, Misses: 1, Puts: 0, Removals: 0}, collection.Stats())
+}
+
+func TestInstrumentedSet_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet(0)
+	require.True(test, collection.Remove(0))
+	require.False(test, collection.Remove(0))
+	require.Equal(test, uint64(1), collection.Stats().Removals)
+}
+
+func TestInstrumentedSet_Size(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, 1, NewInstrumentedSet(0, 0).Size())
+}
+
+func TestInstrumentedSet_Slice(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, []int{0}, NewInstrumentedSet(0).Slice())
+}
+
+func TestInstrumentedSet_Stats(test *testing.T) {
+	test.Parallel()
+
+	collection := NewInstrumentedSet(0)
+	collection.Add(1)
+	collection.Contains(1)
+	collection.Remove(1)
+	require.Equal(test, OperationStats{Gets: 1, Hits: 1, Misses: 0, Puts: 1, Removals: 1}, collection.Stats())
+}
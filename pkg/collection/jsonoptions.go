@@ -0,0 +1,83 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONOptions controls how collections are marshaled to and unmarshaled from
+// JSON by the MarshalJSONWith and UnmarshalJSONWith methods. The zero value
+// emits empty collections as [] or {}, leaves sets unordered, accepts
+// duplicates and unknown fields, and requires lists and sets to be arrays.
+type JSONOptions struct {
+	// NullEmpty emits empty collections as null.
+	NullEmpty bool
+	// SortSets emits the values of sets in sorted order.
+	SortSets bool
+	// DisallowDuplicates rejects duplicate map keys and set values.
+	DisallowDuplicates bool
+	// DisallowUnknownFields rejects object fields that do not match a field of
+	// the destination struct.
+	DisallowUnknownFields bool
+	// AcceptScalar accepts a single value in place of an array when
+	// unmarshaling lists and sets.
+	AcceptScalar bool
+}
+
+// marshalEmpty returns the encoding of an empty collection, which is the
+// specified encoding unless empty collections are emitted as null.
+func (options JSONOptions) marshalEmpty(empty string) (data []byte) {
+	if options.NullEmpty {
+		return []byte("null")
+	}
+	return []byte(empty)
+}
+
+// newDecoder returns a decoder for the specified data that applies the
+// options.
+func (options JSONOptions) newDecoder(data []byte) (decoder *json.Decoder) {
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	if options.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder
+}
+
+// decodeValues returns the values of the specified JSON array, which is empty
+// if the data is null. If the options accept scalars, data that is not an
+// array is decoded as a single value.
+func decodeValues[Value any](data []byte, options JSONOptions) (values []Value, err error) {
+	decoder := options.newDecoder(data)
+	values = make([]Value, 0)
+	trimmed := bytes.TrimSpace(data)
+	if options.AcceptScalar && len(trimmed) > 0 && trimmed[0] != '[' && !bytes.Equal(trimmed, []byte("null")) {
+		var value Value
+		err = decoder.Decode(&value)
+		values = append(values, value)
+	} else {
+		err = decoder.Decode(&values)
+	}
+	if err != nil {
+		return nil, err
+	} else if err = finishJSON(decoder); err != nil {
+		return nil, err
+	} else if values == nil {
+		values = make([]Value, 0)
+	}
+	return values, nil
+}
+
+// finishJSON returns an error if the specified decoder has input remaining
+// after a complete value.
+func finishJSON(decoder *json.Decoder) (err error) {
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrUnexpectedToken, token)
+}
@@ -3,19 +3,324 @@
 package collection
 
 import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
 	"reflect"
 	"sort"
+	"strings"
+	"sync"
 )
 
-// ErrIndexOutOfRange indicates that an index was out of range.
-var ErrIndexOutOfRange = errors.New("index out of range")
+var (
+	// ErrIndexOutOfRange indicates that an index was out of range.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrInvalidPageSize indicates that a page size was not positive.
+	ErrInvalidPageSize = errors.New("invalid page size")
+	// ErrOverlappingRanges indicates that two ranges overlapped.
+	ErrOverlappingRanges = errors.New("overlapping ranges")
+	// ErrUnexpectedToken indicates that a JSON token was not expected.
+	ErrUnexpectedToken = errors.New("unexpected token")
+)
 
 // List represents an ordered collection of values.
 type List[Value any] []Value
 
+// Chunk returns a list of partitions of the specified size over the values of
+// the specified list. Each partition is a copy, and the last partition contains
+// the remaining values. Chunk is a function because a method of List cannot
+// return a list of lists.
+func Chunk[Value any](collection List[Value], size int) (chunks List[List[Value]]) {
+	chunks = make(List[List[Value]], 0)
+	collection.Partitions(size, func(values []Value) bool {
+		chunks = append(chunks, ListFromSlice(values))
+		return true
+	})
+	return chunks
+}
+
+// CollectList returns a list containing the values produced by the specified
+// sequence. The sequence is compatible with iter.Seq.
+func CollectList[Value any](sequence func(yield func(value Value) (next bool))) (collection List[Value]) {
+	collection = make(List[Value], 0)
+	sequence(func(value Value) bool {
+		collection = append(collection, value)
+		return true
+	})
+	return collection
+}
+
+// FoldList combines the values of the specified list, in order, by applying the
+// specified function to the result so far and each value after the first,
+// starting from the first value. Folding stops early if the function returns
+// true for stop. The result and true are returned, or the zero value and false
+// if the list is empty.
+func FoldList[Value any](
+	collection List[Value], fold func(accumulator Value, value Value) (result Value, stop bool),
+) (result Value, exists bool) {
+	if len(collection) == 0 {
+		return result, false
+	}
+	result = collection[0]
+	for _, value := range collection[1:] {
+		var stop bool
+		if result, stop = fold(result, value); stop {
+			break
+		}
+	}
+	return result, true
+}
+
+// Generate returns a list containing the specified number of values, each
+// produced by calling the specified generator with its position.
+func Generate[Value any](size int, generator func(index int) (value Value)) (collection List[Value]) {
+	if size < 0 {
+		size = 0
+	}
+	collection = make(List[Value], size)
+	for index := range collection {
+		collection[index] = generator(index)
+	}
+	return collection
+}
+
+// GroupAdjacent returns a list of groups of consecutive values of the
+// specified list for which the specified function returns true when comparing
+// each value to the value before it. Each group is a copy, and the groups are
+// in list order. GroupAdjacent is a function because a method of List cannot
+// return a list of lists.
+func GroupAdjacent[Value any](
+	collection List[Value], same func(this Value, that Value) (same bool),
+) (groups List[List[Value]]) {
+	groups = make(List[List[Value]], 0)
+	start := 0
+	for index := 1; index <= len(collection); index++ {
+		if index == len(collection) || !same(collection[index-1], collection[index]) {
+			groups = append(groups, ListFromSlice(collection[start:index]))
+			start = index
+		}
+	}
+	return groups
+}
+
+// IndexBy returns a map that associates the key produced by the specified key
+// function with each value of the list. If more than one value produces the
+// same key, an error is returned instead.
+func IndexBy[Value any, Key comparable](
+	collection List[Value], keyFunc func(value Value) (key Key),
+) (elements Map[Key, Value], err error) {
+	elements = make(Map[Key, Value], len(collection))
+	for _, value := range collection {
+		key := keyFunc(value)
+		if _, exists := elements[key]; exists {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+		}
+		elements[key] = value
+	}
+	return elements, nil
+}
+
+// IndexByMulti returns a map that associates the key produced by the specified
+// key function with the values of the list that produce it, in order.
+func IndexByMulti[Value any, Key comparable](
+	collection List[Value], keyFunc func(value Value) (key Key),
+) (elements Map[Key, List[Value]]) {
+	elements = make(Map[Key, List[Value]])
+	for _, value := range collection {
+		key := keyFunc(value)
+		elements[key] = append(elements[key], value)
+	}
+	return elements
+}
+
+// Interleave returns a list containing the values of the specified lists in
+// round-robin order, taking one value from each list in turn and skipping
+// lists that have been exhausted.
+func Interleave[Value any](lists ...List[Value]) (collection List[Value]) {
+	size := 0
+	for _, list := range lists {
+		size += len(list)
+	}
+	collection = make(List[Value], 0, size)
+	RoundRobin(lists...)(func(value Value) bool {
+		collection = append(collection, value)
+		return true
+	})
+	return collection
+}
+
+// ListFromChannel returns a list containing the values received from the
+// specified channel until it is closed or the context is done, in which case
+// the values received so far are returned along with the context error.
+func ListFromChannel[Value any](ctx context.Context, channel <-chan Value) (collection List[Value], err error) {
+	collection = make(List[Value], 0)
+	err = collection.AddFromChannel(ctx, channel)
+	return collection, err
+}
+
+// ListFromSlice returns a list containing a copy of the specified values.
+func ListFromSlice[Value any](values []Value) (collection List[Value]) {
+	return append(make(List[Value], 0, len(values)), values...)
+}
+
+// ListOf returns a list containing the specified values.
+func ListOf[Value any](values ...Value) (collection List[Value]) {
+	return append(make(List[Value], 0, len(values)), values...)
+}
+
+// NewList returns an empty list with at least the specified initial capacity,
+// reusing storage released by ReleaseToPool, with all of its capacity, if
+// available.
+func NewList[Value any](capacity int) (collection List[Value]) {
+	return newSlice[Value](capacity)
+}
+
+// RangeList returns a list containing the values from the specified start
+// toward the specified end, exclusive, separated by the specified step. A
+// negative step produces descending values, and a zero, NaN, or infinite step
+// produces an empty list. The list ends early if the next value would overflow
+// the type or would not differ from the previous value.
+func RangeList[Value Number](start Value, end Value, step Value) (collection List[Value]) {
+	collection = make(List[Value], 0)
+	if step == 0 || step-step != 0 {
+		return collection
+	}
+	for value := start; (step > 0 && value < end) || (step < 0 && value > end); {
+		collection = append(collection, value)
+		next := value + step
+		if (step > 0 && next <= value) || (step < 0 && next >= value) {
+			break
+		}
+		value = next
+	}
+	return collection
+}
+
+// ReadJSONLines returns a list containing the values decoded from each line of
+// the specified reader, which contains one JSON value per line as written by
+// WriteJSONLines. Blank lines are skipped.
+func ReadJSONLines[Value any](reader io.Reader) (collection List[Value], err error) {
+	buffered := bufio.NewReader(reader)
+	collection = make(List[Value], 0)
+	for line := 1; ; line++ {
+		data, readErr := buffered.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			var value Value
+			if err = json.Unmarshal(data, &value); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			collection = append(collection, value)
+		}
+		if errors.Is(readErr, io.EOF) {
+			return collection, nil
+		} else if readErr != nil {
+			return nil, readErr
+		}
+	}
+}
+
+// ReduceList combines the values of the specified list, in order, by applying
+// the specified function to the result so far and each value, starting from the
+// specified initial result. Reducing stops early if the function returns true
+// for stop, and the result returned with it is the final result.
+func ReduceList[Value any, Result any](
+	collection List[Value], initial Result, reduce func(accumulator Result, value Value) (result Result, stop bool),
+) (result Result) {
+	result = initial
+	for _, value := range collection {
+		var stop bool
+		if result, stop = reduce(result, value); stop {
+			break
+		}
+	}
+	return result
+}
+
+// Repeat returns a list containing the specified value the specified number of
+// times.
+func Repeat[Value any](value Value, size int) (collection List[Value]) {
+	if size < 0 {
+		size = 0
+	}
+	collection = make(List[Value], size)
+	collection.Fill(value)
+	return collection
+}
+
+// RoundRobin returns a sequence of the values of the specified lists in
+// round-robin order, taking one value from each list in turn and skipping
+// lists that have been exhausted. The sequence is compatible with iter.Seq.
+func RoundRobin[Value any](lists ...List[Value]) (sequence func(yield func(value Value) (next bool))) {
+	return func(yield func(value Value) (next bool)) {
+		for index, remaining := 0, true; remaining; index++ {
+			remaining = false
+			for _, list := range lists {
+				if index < len(list) {
+					remaining = true
+					if !yield(list[index]) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Scan returns a list containing the running results of applying the specified
+// accumulator to each value of the specified list, starting from the specified
+// initial result. The initial result is not included, so the returned list has
+// the same size as the specified list, and its last value is the total.
+func Scan[Value any, Result any](
+	collection List[Value], initial Result, accumulate func(accumulator Result, value Value) (result Result),
+) (results List[Result]) {
+	results = make(List[Result], 0, len(collection))
+	for _, value := range collection {
+		initial = accumulate(initial, value)
+		results = append(results, initial)
+	}
+	return results
+}
+
+// ToMap returns a map that associates the keys produced by the specified key
+// function with the values produced by the specified value function for each
+// value of the list. Later values replace earlier values with the same key.
+func ToMap[Value any, Key comparable, Result any](
+	collection List[Value], keyFunc func(value Value) (key Key), valueFunc func(value Value) (result Result),
+) (elements Map[Key, Result]) {
+	elements = make(Map[Key, Result], len(collection))
+	for index := range collection {
+		elements[keyFunc(collection[index])] = valueFunc(collection[index])
+	}
+	return elements
+}
+
+// ToSet returns a set containing the values of the specified list.
+func ToSet[Value comparable](collection List[Value]) (values Set[Value]) {
+	return SetFromSlice(collection)
+}
+
+// TryMapList returns a list containing the results of applying the specified
+// transform to each value of the specified list. If the transform returns an
+// error, no list is returned. If fail fast is true, the first error is
+// returned immediately; otherwise, every value is transformed and the errors
+// are joined in list order. Each error is annotated with the index of its
+// value.
+func TryMapList[Value any, Result any](
+	collection List[Value], transform func(value Value) (result Result, err error), failFast bool,
+) (results List[Result], err error) {
+	if failFast {
+		return ConvertList(collection, transform, ConvertFailFast)
+	}
+	return ConvertList(collection, transform, ConvertCollectErrors)
+}
+
 // Add ensures that the list contains the specified value.
 func (collection *List[Value]) Add(value Value) (modified bool) {
 	*collection = append(*collection, value)
@@ -28,6 +333,47 @@ func (collection *List[Value]) AddAll(values ...Value) (modified bool) {
 	return len(values) != 0
 }
 
+// AddFromChannel adds the values received from the specified channel to the end
+// of the list until the channel is closed or the context is done, in which
+// case the context error is returned.
+func (collection *List[Value]) AddFromChannel(ctx context.Context, channel <-chan Value) (err error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case value, open := <-channel:
+			if !open {
+				return nil
+			}
+			*collection = append(*collection, value)
+		}
+	}
+}
+
+// AppendBatch adds all of the specified values to the end of the list, growing
+// the list at most once. Unlike AddAll, the values are passed as a slice, so
+// an existing batch can be appended without expanding it.
+func (collection *List[Value]) AppendBatch(values []Value) {
+	*collection = append(*collection, values...)
+}
+
+// AsReadOnly returns a read-only view of the list.
+func (collection *List[Value]) AsReadOnly() (view UnmodifiableList[Value]) {
+	return UnmodifiableList[Value]{collection: collection}
+}
+
+// AsSlice returns the values of the list as a slice without copying them.
+// Changes to the values of the slice are visible in the list and vice versa.
+func (collection List[Value]) AsSlice() (values []Value) {
+	return collection
+}
+
+// At returns the value at the specified position in the list. A negative
+// position counts back from the end of the list, so -1 is the last value.
+func (collection List[Value]) At(index int) (current Value, err error) {
+	return collection.Get(collection.resolve(index))
+}
+
 // Clear removes all of the values from the list.
 func (collection *List[Value]) Clear() (modified bool) {
 	modified = len(*collection) > 0
@@ -35,6 +381,30 @@ func (collection *List[Value]) Clear() (modified bool) {
 	return modified
 }
 
+// Clone returns a copy of the list.
+func (collection List[Value]) Clone() (clone List[Value]) {
+	return ListFromSlice(collection)
+}
+
+// Compact removes all zero values from the list, preserving the order of the
+// remaining values. This method uses reflection to test for zero values.
+func (collection *List[Value]) Compact() (modified bool) {
+	index := 0
+	for jndex := range *collection {
+		if !reflect.ValueOf(&(*collection)[jndex]).Elem().IsZero() {
+			(*collection)[index] = (*collection)[jndex]
+			index++
+		}
+	}
+	modified = index != len(*collection)
+	var empty Value
+	for jndex := index; jndex < len(*collection); jndex++ {
+		(*collection)[jndex] = empty
+	}
+	*collection = (*collection)[:index]
+	return modified
+}
+
 // Contains returns true if the list contains the specified value. This method
 // uses reflection to test equality.
 func (collection List[Value]) Contains(value Value) (contains bool) {
@@ -61,6 +431,77 @@ OuterLoop:
 	return true
 }
 
+// ContainsAny returns true if the list contains at least one of the specified
+// values. This method uses reflection to test equality.
+func (collection List[Value]) ContainsAny(values ...Value) (contains bool) {
+	for index := range values {
+		for jndex := range collection {
+			if reflect.DeepEqual(collection[jndex], values[index]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ContainsList returns true if the specified list occurs as a contiguous
+// sublist of the list. This method uses reflection to test equality.
+func (collection List[Value]) ContainsList(other List[Value]) (contains bool) {
+	for index := 0; index+len(other) <= len(collection); index++ {
+		if collection.matches(index, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of occurrences of the specified value in the list.
+// This method uses reflection to test equality.
+func (collection List[Value]) Count(value Value) (count int) {
+	for index := range collection {
+		if reflect.DeepEqual(collection[index], value) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountFunc returns the number of values in the list for which the specified
+// predicate returns true.
+func (collection List[Value]) CountFunc(predicate func(value Value) (match bool)) (count int) {
+	for index := range collection {
+		if predicate(collection[index]) {
+			count++
+		}
+	}
+	return count
+}
+
+// DecodeJSON adds the values of a JSON array read from the specified decoder to
+// the list, one value at a time. If the specified action is not nil, it is
+// performed for each value after it has been added, and decoding stops early
+// if the action returns false.
+func (collection *List[Value]) DecodeJSON(decoder *json.Decoder, action func(value Value) (next bool)) (err error) {
+	token, err := decoder.Token()
+	if err != nil || token == nil {
+		return err
+	} else if delim, valid := token.(json.Delim); !valid || delim != '[' {
+		return fmt.Errorf("%w: %v", ErrUnexpectedToken, token)
+	}
+	for decoder.More() {
+		var value Value
+		if err = decoder.Decode(&value); err != nil {
+			return err
+		}
+		*collection = append(*collection, value)
+		if action != nil && !action(value) {
+			return nil
+		}
+	}
+	_, err = decoder.Token()
+	return err
+}
+
 // Delete removes the value at the specified position in the list, returning
 // the previous value.
 func (collection *List[Value]) Delete(index int) (previous Value, err error) {
@@ -76,12 +517,105 @@ func (collection *List[Value]) Delete(index int) (previous Value, err error) {
 	return previous, err
 }
 
+// DeleteAllIndexes removes the values at all of the specified positions in the
+// list in a single pass. If any position is out of range, no values are
+// removed.
+func (collection *List[Value]) DeleteAllIndexes(indexes ...int) (err error) {
+	marked := make(map[int]struct{}, len(indexes))
+	for _, index := range indexes {
+		if index < 0 || index >= len(*collection) {
+			return ErrIndexOutOfRange
+		}
+		marked[index] = struct{}{}
+	}
+	index := 0
+	for jndex := range *collection {
+		if _, exists := marked[jndex]; !exists {
+			(*collection)[index] = (*collection)[jndex]
+			index++
+		}
+	}
+	var empty Value
+	for jndex := index; jndex < len(*collection); jndex++ {
+		(*collection)[jndex] = empty
+	}
+	*collection = (*collection)[:index]
+	return nil
+}
+
+// DeleteAt removes the value at the specified position in the list, returning
+// the previous value. A negative position counts back from the end of the
+// list, so -1 is the last value.
+func (collection *List[Value]) DeleteAt(index int) (previous Value, err error) {
+	return collection.Delete(collection.resolve(index))
+}
+
+// DeleteRange removes the values from the first position, inclusive, to the
+// second position, exclusive, returning the previous values.
+func (collection *List[Value]) DeleteRange(from int, to int) (removed []Value, err error) {
+	if from < 0 || from > to || to > len(*collection) {
+		return nil, ErrIndexOutOfRange
+	}
+	removed = append(make([]Value, 0, to-from), (*collection)[from:to]...)
+	index := copy((*collection)[from:], (*collection)[to:]) + from
+	var empty Value
+	for jndex := index; jndex < len(*collection); jndex++ {
+		(*collection)[jndex] = empty
+	}
+	*collection = (*collection)[:index]
+	return removed, nil
+}
+
+// EndsWith returns true if the list ends with the specified list. This method
+// uses reflection to test equality.
+func (collection List[Value]) EndsWith(suffix List[Value]) (ends bool) {
+	return len(suffix) <= len(collection) && collection.matches(len(collection)-len(suffix), suffix)
+}
+
 // Equal compares the list to the specified values for equality. This method
 // uses reflection to test equality.
 func (collection List[Value]) Equal(values ...Value) (equal bool) {
 	return reflect.DeepEqual([]Value(collection), values)
 }
 
+// EqualFunc compares the list to the specified values for equality, using the
+// specified comparator to test the equality of each pair of values.
+func (collection List[Value]) EqualFunc(
+	values []Value, comparator func(this Value, that Value) (equal bool),
+) (equal bool) {
+	if len(collection) != len(values) {
+		return false
+	}
+	for index, value := range collection {
+		if !comparator(value, values[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualList compares the list to the specified list for equality. Unlike
+// Equal, a nil list is equal to an empty list. This method uses reflection to
+// test equality.
+func (collection List[Value]) EqualList(other List[Value]) (equal bool) {
+	return len(collection) == len(other) && collection.matches(0, other)
+}
+
+// Fill replaces every value in the list with the specified value.
+func (collection List[Value]) Fill(value Value) {
+	for index := range collection {
+		collection[index] = value
+	}
+}
+
+// First returns the first value of the list, or false if the list is empty.
+func (collection List[Value]) First() (value Value, exists bool) {
+	if len(collection) == 0 {
+		return value, false
+	}
+	return collection[0], true
+}
+
 // ForEach performs the specified action for each value of the list until all
 // values have been processed or the action returns false.
 func (collection List[Value]) ForEach(action func(value Value) (next bool)) {
@@ -92,6 +626,59 @@ func (collection List[Value]) ForEach(action func(value Value) (next bool)) {
 	}
 }
 
+// ForEachCtx performs the specified action for each value of the list until all
+// values have been processed, the action returns false, or the context is
+// done, in which case the context error is returned.
+func (collection List[Value]) ForEachCtx(ctx context.Context, action func(value Value) (next bool)) (err error) {
+	for index := range collection {
+		if err = ctx.Err(); err != nil {
+			return err
+		} else if !action(collection[index]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ForEachErr performs the specified action for each value of the list until all
+// values have been processed or the action returns an error, which is
+// returned.
+func (collection List[Value]) ForEachErr(action func(value Value) (err error)) (err error) {
+	for index := range collection {
+		if err = action(collection[index]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachSnapshot performs the specified action for each value of a copy of
+// the list, taken while holding the specified lock, until all values have been
+// processed or the action returns false. The lock is released before the
+// action is called, so the action may modify the list.
+func (collection *List[Value]) ForEachSnapshot(lock sync.Locker, action func(value Value) (next bool)) {
+	lock.Lock()
+	snapshot := collection.Clone()
+	lock.Unlock()
+	snapshot.ForEach(action)
+}
+
+// Format implements fmt.Formatter. The %#v verb prints a Go-syntax
+// representation of the list, and the %+v verb includes struct field names.
+func (collection List[Value]) Format(state fmt.State, verb rune) {
+	formatCollection(state, verb, collection, []Value(collection), []Value(collection))
+}
+
+// Generate implements quick.Generator, returning a random list of up to the
+// specified number of values.
+func (collection List[Value]) Generate(random *rand.Rand, size int) (value reflect.Value) {
+	values := make(List[Value], 0)
+	generateValues(random, size, func(value Value) {
+		values = append(values, value)
+	})
+	return reflect.ValueOf(values)
+}
+
 // Get returns the value at the specified position in the list.
 func (collection List[Value]) Get(index int) (current Value, err error) {
 	if index >= 0 && index < len(collection) {
@@ -143,6 +730,35 @@ func (collection List[Value]) IsEmpty() (empty bool) {
 	return len(collection) == 0
 }
 
+// Join returns the string representations of the values of the list,
+// separated by the specified separator.
+func (collection List[Value]) Join(separator string) (values string) {
+	return collection.JoinFunc(separator, func(value Value) string {
+		return fmt.Sprint(value)
+	})
+}
+
+// JoinFunc returns the string representations produced by the specified
+// function for each value of the list, separated by the specified separator.
+func (collection List[Value]) JoinFunc(separator string, stringer func(value Value) (text string)) (values string) {
+	var builder strings.Builder
+	for index := range collection {
+		if index > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(stringer(collection[index]))
+	}
+	return builder.String()
+}
+
+// Last returns the last value of the list, or false if the list is empty.
+func (collection List[Value]) Last() (value Value, exists bool) {
+	if len(collection) == 0 {
+		return value, false
+	}
+	return collection[len(collection)-1], true
+}
+
 // LastIndexOf returns the index of the last occurrence of the specified value
 // in the list, or -1 if the list does not contain the specified value. This
 // method uses reflection to test equality.
@@ -155,11 +771,146 @@ func (collection List[Value]) LastIndexOf(value Value) (index int) {
 	return -1
 }
 
+// MarshalBinary returns a compact binary representation of the list. Only
+// lists of strings and fixed-size values are supported.
+func (collection List[Value]) MarshalBinary() (values []byte, err error) {
+	values = appendBinaryHeader(make([]byte, 0), len(collection))
+	for index := range collection {
+		if values, err = appendBinary(values, reflect.ValueOf(&collection[index]).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
 // MarshalJSON returns a byte representation of the list.
 func (collection List[Value]) MarshalJSON() (values []byte, err error) {
 	return json.Marshal([]Value(collection))
 }
 
+// MarshalJSONWith returns a byte representation of the list using the
+// specified options.
+func (collection List[Value]) MarshalJSONWith(options JSONOptions) (values []byte, err error) {
+	if len(collection) == 0 {
+		return options.marshalEmpty("[]"), nil
+	}
+	return json.Marshal([]Value(collection))
+}
+
+// MaxBy returns the largest value of the list according to the specified
+// comparator and true, or the zero value and false if the list is empty. If
+// more than one value is largest, the first is returned.
+func (collection List[Value]) MaxBy(comparator func(this Value, that Value) (less bool)) (value Value, exists bool) {
+	for index, current := range collection {
+		if index == 0 || comparator(value, current) {
+			value, exists = current, true
+		}
+	}
+	return value, exists
+}
+
+// MinBy returns the smallest value of the list according to the specified
+// comparator and true, or the zero value and false if the list is empty. If
+// more than one value is smallest, the first is returned.
+func (collection List[Value]) MinBy(comparator func(this Value, that Value) (less bool)) (value Value, exists bool) {
+	for index, current := range collection {
+		if index == 0 || comparator(current, value) {
+			value, exists = current, true
+		}
+	}
+	return value, exists
+}
+
+// Move moves the value at the first position to the second position, shifting
+// the values between them by one position.
+func (collection List[Value]) Move(from int, to int) (err error) {
+	return collection.MoveRange(from, 1, to)
+}
+
+// MoveRange moves the specified number of values starting at the first
+// position so that they start at the second position, shifting the values
+// between them by the number of values moved.
+func (collection List[Value]) MoveRange(from int, count int, to int) (err error) {
+	if from < 0 || count < 0 || from+count > len(collection) || to < 0 || to+count > len(collection) {
+		return ErrIndexOutOfRange
+	} else if to < from {
+		collection[to : from+count].Rotate(count)
+	} else if to > from {
+		collection[from : to+count].Rotate(-count)
+	}
+	return nil
+}
+
+// NLargest returns the specified number of largest values of the list
+// according to the specified comparator, ordered from largest to smallest.
+// This method requires time proportional to the size of the list times the
+// logarithm of the specified number.
+func (collection List[Value]) NLargest(
+	size int, comparator func(this Value, that Value) (less bool),
+) (values List[Value]) {
+	return collection.top(size, comparator)
+}
+
+// NSmallest returns the specified number of smallest values of the list
+// according to the specified comparator, ordered from smallest to largest.
+// This method requires time proportional to the size of the list times the
+// logarithm of the specified number.
+func (collection List[Value]) NSmallest(
+	size int, comparator func(this Value, that Value) (less bool),
+) (values List[Value]) {
+	return collection.top(size, func(this Value, that Value) bool {
+		return comparator(that, this)
+	})
+}
+
+// Page returns a copy of the values on the specified page of the list, where
+// pages are numbered from zero and contain the specified number of values. The
+// first page of an empty list is empty, and the last page may contain fewer
+// values than the page size.
+func (collection List[Value]) Page(page int, size int) (values List[Value], err error) {
+	if size <= 0 {
+		return nil, ErrInvalidPageSize
+	} else if page < 0 || (page > 0 && page >= collection.PageCount(size)) {
+		return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, page)
+	}
+	from := page * size
+	to := len(collection)
+	if size < to-from {
+		to = from + size
+	}
+	return ListFromSlice(collection[from:to]), nil
+}
+
+// PageCount returns the number of pages of the specified size needed to hold
+// all of the values in the list, or zero if the page size is not positive.
+func (collection List[Value]) PageCount(size int) (pages int) {
+	if size <= 0 {
+		return 0
+	}
+	return (len(collection) + size - 1) / size
+}
+
+// ParallelForEach performs the specified action for each value of the list
+// using the specified number of goroutines, until all values have been
+// processed or the action returns false. Values are not processed in order. If
+// the number of goroutines is less than one, GOMAXPROCS goroutines are used. If
+// the action panics, the panic is propagated to the caller.
+func (collection List[Value]) ParallelForEach(workers int, action func(value Value) (next bool)) {
+	_ = collection.ParallelForEachCtx(context.Background(), workers, action)
+}
+
+// ParallelForEachCtx performs the specified action for each value of the list
+// using the specified number of goroutines, until all values have been
+// processed, the action returns false, or the context is done, in which case
+// the context error is returned. Values are not processed in order. If the
+// number of goroutines is less than one, GOMAXPROCS goroutines are used. If
+// the action panics, the panic is propagated to the caller.
+func (collection List[Value]) ParallelForEachCtx(
+	ctx context.Context, workers int, action func(value Value) (next bool),
+) (err error) {
+	return parallelForEach(ctx, workers, collection.ForEach, action)
+}
+
 // Partitions performs the specified action for each partition of the specified
 // size over the values of the list.
 func (collection List[Value]) Partitions(size int, action func(values []Value) (next bool)) {
@@ -175,6 +926,46 @@ func (collection List[Value]) Partitions(size int, action func(values []Value) (
 	}
 }
 
+// PartitionsCtx performs the specified action for each partition of the
+// specified size over the values of the list, until all partitions have been
+// processed, the action returns false, or the context is done, in which case
+// the context error is returned.
+func (collection List[Value]) PartitionsCtx(
+	ctx context.Context, size int, action func(values []Value) (next bool),
+) (err error) {
+	collection.Partitions(size, func(values []Value) bool {
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		return action(values)
+	})
+	return err
+}
+
+// PopFirst removes and returns the first value of the list, or false if the
+// list is empty.
+func (collection *List[Value]) PopFirst() (value Value, exists bool) {
+	value, err := collection.Delete(0)
+	return value, err == nil
+}
+
+// PopLast removes and returns the last value of the list, or false if the list
+// is empty.
+func (collection *List[Value]) PopLast() (value Value, exists bool) {
+	value, err := collection.Delete(len(*collection) - 1)
+	return value, err == nil
+}
+
+// ReleaseToPool removes all of the values from the list and releases its
+// storage for reuse by NewList. The storage must not be used through any other
+// reference to the list after it has been released.
+func (collection *List[Value]) ReleaseToPool() {
+	if *collection != nil {
+		releaseSlice([]Value(*collection))
+	}
+	*collection = nil
+}
+
 // Remove removes a single instance of the specified value from the list. This
 // method uses reflection to test equality.
 func (collection *List[Value]) Remove(value Value) (modified bool) {
@@ -206,7 +997,10 @@ OuterLoop:
 		index++
 	}
 	modified = index != len(*collection)
-	copy((*collection)[index:], make([]Value, len(*collection)-index))
+	var empty Value
+	for jndex := index; jndex < len(*collection); jndex++ {
+		(*collection)[jndex] = empty
+	}
 	*collection = (*collection)[:index]
 	return modified
 }
@@ -226,7 +1020,10 @@ OuterLoop:
 		index++
 	}
 	modified = index != len(*collection)
-	copy((*collection)[index:], make([]Value, len(*collection)-index))
+	var empty Value
+	for jndex := index; jndex < len(*collection); jndex++ {
+		(*collection)[jndex] = empty
+	}
 	*collection = (*collection)[:index]
 	return modified
 }
@@ -238,6 +1035,22 @@ func (collection List[Value]) Reverse() {
 	}
 }
 
+// Rotate moves each value of the list the specified number of positions toward
+// the end of the list, wrapping around to the beginning. A negative number of
+// positions rotates toward the beginning of the list.
+func (collection List[Value]) Rotate(positions int) {
+	if len(collection) == 0 {
+		return
+	}
+	positions %= len(collection)
+	if positions < 0 {
+		positions += len(collection)
+	}
+	collection.Reverse()
+	collection[:positions].Reverse()
+	collection[positions:].Reverse()
+}
+
 // Set replaces the value at the specified position in the list with the
 // specified value.
 func (collection List[Value]) Set(index int, value Value) (err error) {
@@ -249,12 +1062,20 @@ func (collection List[Value]) Set(index int, value Value) (err error) {
 	return err
 }
 
+// SetAt replaces the value at the specified position in the list with the
+// specified value. A negative position counts back from the end of the list,
+// so -1 is the last value.
+func (collection List[Value]) SetAt(index int, value Value) (err error) {
+	return collection.Set(collection.resolve(index), value)
+}
+
 // Size returns the number of values in the list.
 func (collection List[Value]) Size() (size int) {
 	return len(collection)
 }
 
-// Slice returns a slice containing all of the values in the list.
+// Slice returns a slice containing all of the values in the list. The slice is
+// a copy; use AsSlice to avoid copying.
 func (collection List[Value]) Slice() (values []Value) {
 	return append(make([]Value, 0, len(collection)), collection...)
 }
@@ -267,6 +1088,33 @@ func (collection List[Value]) Sort(comparator func(this Value, that Value) (swap
 	})
 }
 
+// Split divides the list into the specified number of segments whose sizes
+// differ by at most one, in order. The segments share storage with the list,
+// but adding values to a segment never modifies the next segment. If the
+// number of segments is less than one, no segments are returned.
+func (collection List[Value]) Split(count int) (segments []List[Value]) {
+	if count <= 0 {
+		return make([]List[Value], 0)
+	}
+	segments = make([]List[Value], 0, count)
+	size, remainder := len(collection)/count, len(collection)%count
+	for index, from := 0, 0; index < count; index++ {
+		to := from + size
+		if index < remainder {
+			to++
+		}
+		segments = append(segments, collection[from:to:to])
+		from = to
+	}
+	return segments
+}
+
+// StartsWith returns true if the list starts with the specified list. This
+// method uses reflection to test equality.
+func (collection List[Value]) StartsWith(prefix List[Value]) (starts bool) {
+	return len(prefix) <= len(collection) && collection.matches(0, prefix)
+}
+
 // String returns a string representation of the list.
 func (collection List[Value]) String() (values string) {
 	return fmt.Sprint([]Value(collection))
@@ -284,9 +1132,191 @@ func (collection List[Value]) Swap(index int, value Value) (previous Value, err
 	return previous, err
 }
 
+// SwapRanges exchanges the specified number of values starting at the first
+// position with the same number of values starting at the second position. The
+// ranges must not overlap.
+func (collection List[Value]) SwapRanges(index int, jndex int, length int) (err error) {
+	if index < 0 || jndex < 0 || length < 0 || index+length > len(collection) || jndex+length > len(collection) {
+		return ErrIndexOutOfRange
+	} else if index < jndex+length && jndex < index+length && length > 0 {
+		return ErrOverlappingRanges
+	}
+	for kndex := 0; kndex < length; kndex++ {
+		collection[index+kndex], collection[jndex+kndex] = collection[jndex+kndex], collection[index+kndex]
+	}
+	return nil
+}
+
+// ToChannel returns a channel that receives each value of the list, in order.
+// The values are copied when this method is called, and the channel is closed
+// once all values have been sent or the context is done.
+func (collection List[Value]) ToChannel(ctx context.Context) (channel <-chan Value) {
+	return sendAll(ctx, collection.Slice())
+}
+
+// UnmarshalBinary replaces all of the list's values with the specified binary
+// representation.
+func (collection *List[Value]) UnmarshalBinary(values []byte) (err error) {
+	reader := bytes.NewReader(values)
+	size, empty, err := readBinaryHeader(reader, reflect.TypeOf((*Value)(nil)).Elem())
+	if err != nil {
+		return err
+	}
+	buffer := make([]Value, size)
+	for index := 0; index < size && !empty; index++ {
+		if err = readBinary(reader, reflect.ValueOf(&buffer[index]).Elem()); err != nil {
+			return err
+		}
+	}
+	*collection = buffer
+	return nil
+}
+
 // UnmarshalJSON replaces all of the list's values with the specified values.
+// JSON null is treated as an empty list.
 func (collection *List[Value]) UnmarshalJSON(values []byte) (err error) {
 	collection.Clear()
 	err = json.Unmarshal(values, (*[]Value)(collection))
+	if *collection == nil {
+		*collection = make([]Value, 0)
+	}
 	return err
 }
+
+// UnmarshalJSONWith replaces all of the list's values with the specified
+// values using the specified options.
+func (collection *List[Value]) UnmarshalJSONWith(values []byte, options JSONOptions) (err error) {
+	buffer, err := decodeValues[Value](values, options)
+	if err != nil {
+		return err
+	}
+	*collection = buffer
+	return nil
+}
+
+// matches returns true if the values of the list starting at the specified
+// offset are equal to the values of the specified list.
+func (collection List[Value]) matches(offset int, other List[Value]) (equal bool) {
+	for index := range other {
+		if !reflect.DeepEqual(collection[offset+index], other[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// WeightedSample returns a value of the list chosen at random, with each value
+// chosen with probability proportional to the weight returned by the specified
+// function, and true. Values with weights that are not positive are never
+// chosen. If no value has a positive weight, the zero value and false are
+// returned. If the specified source of randomness is nil, the default source
+// is used.
+func (collection List[Value]) WeightedSample(
+	weights func(value Value) (weight float64), random *rand.Rand,
+) (value Value, exists bool) {
+	total := 0.0
+	for _, current := range collection {
+		if weight := weights(current); weight > 0 {
+			total += weight
+		}
+	}
+	if total <= 0 || math.IsInf(total, 0) {
+		return value, false
+	}
+	target := randomFloat(random) * total
+	for _, current := range collection {
+		if weight := weights(current); weight > 0 {
+			value, exists = current, true
+			if target -= weight; target < 0 {
+				break
+			}
+		}
+	}
+	return value, exists
+}
+
+// WeightedShuffle randomly reorders the values of the list, so that values
+// with greater weights, as returned by the specified function, tend to appear
+// earlier. The first value is chosen with probability proportional to its
+// weight, and so on for the remaining values. Values with weights that are not
+// positive are moved to the end of the list in their original order. If the
+// specified source of randomness is nil, the default source is used.
+func (collection List[Value]) WeightedShuffle(weights func(value Value) (weight float64), random *rand.Rand) {
+	keys := make([]float64, len(collection))
+	order := make([]int, len(collection))
+	for index, value := range collection {
+		order[index] = index
+		if weight := weights(value); weight > 0 {
+			keys[index] = math.Log(1-randomFloat(random)) / weight
+		} else {
+			keys[index] = math.Inf(-1)
+		}
+	}
+	sort.SliceStable(order, func(index int, jndex int) bool {
+		return keys[order[index]] > keys[order[jndex]]
+	})
+	shuffled := make([]Value, len(collection))
+	for index, position := range order {
+		shuffled[index] = collection[position]
+	}
+	copy(collection, shuffled)
+}
+
+// WriteJSONLines writes each value of the list to the specified writer as JSON
+// followed by a newline, so that values can be appended to and read back from
+// log-structured files.
+func (collection List[Value]) WriteJSONLines(writer io.Writer) (err error) {
+	encoder := json.NewEncoder(writer)
+	for _, value := range collection {
+		if err = encoder.Encode(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve converts a negative position, which counts back from the end of the
+// list, to the equivalent non-negative position.
+func (collection List[Value]) resolve(index int) (resolved int) {
+	if index < 0 {
+		return index + len(collection)
+	}
+	return index
+}
+
+// top returns the specified number of largest values of the list according to
+// the specified comparator, ordered from largest to smallest, using a bounded
+// heap whose root is the smallest value retained so far.
+func (collection List[Value]) top(size int, comparator func(this Value, that Value) (less bool)) (values List[Value]) {
+	if size > len(collection) {
+		size = len(collection)
+	}
+	if size <= 0 {
+		return make(List[Value], 0)
+	}
+	values = make(List[Value], 0, size)
+	adapter := AsHeap(&values, comparator)
+	for _, value := range collection {
+		if len(values) < size {
+			heap.Push(adapter, value)
+		} else if comparator(values[0], value) {
+			values[0] = value
+			heap.Fix(adapter, 0)
+		}
+	}
+	for index := len(values) - 1; index > 0; index-- {
+		values[0], values[index] = values[index], values[0]
+		remaining := values[:index]
+		heap.Fix(AsHeap(&remaining, comparator), 0)
+	}
+	return values
+}
+
+// randomFloat returns a random number in the interval [0, 1) from the
+// specified source of randomness, or the default source if it is nil.
+func randomFloat(random *rand.Rand) (value float64) {
+	if random == nil {
+		return rand.Float64() //nolint:gosec
+	}
+	return random.Float64()
+}
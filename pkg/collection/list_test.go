@@ -1,9 +1,17 @@
 package collection
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +32,264 @@ func ExampleList() {
 	// Output: [0=0 1=1]
 }
 
+func TestChunk(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	chunks := Chunk(collection, 2)
+	require.Equal(test, List[List[int]]{{0, 1}, {2, 3}, {4}}, chunks)
+	require.NoError(test, chunks[0].Set(0, 5))
+	require.True(test, collection.Equal(0, 1, 2, 3, 4))
+	require.Empty(test, Chunk(collection, 0))
+	require.Empty(test, Chunk(List[int]{}, 2))
+}
+
+func TestCollectList(test *testing.T) {
+	test.Parallel()
+
+	collection := CollectList(func(yield func(int) bool) {
+		for index := 0; index < 3; index++ {
+			if !yield(index) {
+				return
+			}
+		}
+	})
+	require.True(test, collection.Equal(0, 1, 2))
+}
+
+func TestFoldList(test *testing.T) {
+	test.Parallel()
+
+	maximum := func(accumulator int, value int) (int, bool) {
+		if value > accumulator {
+			return value, false
+		}
+		return accumulator, false
+	}
+	result, exists := FoldList(ListOf(3, 7, 2), maximum)
+	require.True(test, exists)
+	require.Equal(test, 7, result)
+	_, exists = FoldList(ListOf[int](), maximum)
+	require.False(test, exists)
+	result, _ = FoldList(ListOf(1, 2, 3, 4), func(accumulator int, value int) (int, bool) {
+		return accumulator + value, accumulator+value >= 3
+	})
+	require.Equal(test, 3, result)
+}
+
+func TestGenerate(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, Generate(3, func(index int) int { return index * index }).Equal(0, 1, 4))
+	require.True(test, Generate(-1, func(index int) int { return index }).IsEmpty())
+}
+
+func TestGroupAdjacent(test *testing.T) {
+	test.Parallel()
+
+	equal := func(this int, that int) bool { return this == that }
+	require.Empty(test, GroupAdjacent(ListOf[int](), equal))
+	collection := ListOf(0, 0, 1, 0, 2, 2, 2)
+	groups := GroupAdjacent(collection, equal)
+	require.Equal(test, List[List[int]]{{0, 0}, {1}, {0}, {2, 2, 2}}, groups)
+	groups[0][0] = 3
+	require.Equal(test, 0, collection[0])
+	increasing := GroupAdjacent(collection, func(this int, that int) bool { return that == this+1 })
+	require.Equal(test, List[List[int]]{{0}, {0, 1}, {0}, {2}, {2}, {2}}, increasing)
+}
+
+func TestIndexBy(test *testing.T) {
+	test.Parallel()
+
+	elements, err := IndexBy(ListOf("a", "bc"), func(value string) int { return len(value) })
+	require.NoError(test, err)
+	require.Equal(test, Map[int, string]{1: "a", 2: "bc"}, elements)
+	_, err = IndexBy(ListOf("a", "b"), func(value string) int { return len(value) })
+	require.ErrorIs(test, err, ErrDuplicateKey)
+}
+
+func TestIndexByMulti(test *testing.T) {
+	test.Parallel()
+
+	elements := IndexByMulti(ListOf("a", "bc", "d"), func(value string) int { return len(value) })
+	require.Equal(test, Map[int, List[string]]{1: {"a", "d"}, 2: {"bc"}}, elements)
+}
+
+func TestInterleave(test *testing.T) {
+	test.Parallel()
+
+	collection := Interleave(ListOf(0, 3, 5), ListOf(1), List[int]{}, ListOf(2, 4))
+	require.Equal(test, List[int]{0, 1, 2, 3, 4, 5}, collection)
+	require.Empty(test, Interleave[int]())
+}
+
+func TestListFromChannel(test *testing.T) {
+	test.Parallel()
+
+	channel := make(chan int, 2)
+	channel <- 0
+	channel <- 1
+	close(channel)
+	collection, err := ListFromChannel(context.Background(), channel)
+	require.NoError(test, err)
+	require.True(test, collection.Equal(0, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	collection, err = ListFromChannel(ctx, make(chan int))
+	require.ErrorIs(test, err, context.Canceled)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestListFromSlice(test *testing.T) {
+	test.Parallel()
+
+	values := []int{0, 1}
+	collection := ListFromSlice(values)
+	require.True(test, collection.Equal(0, 1))
+	values[0] = 1
+	require.True(test, collection.Equal(0, 1))
+}
+
+func TestListOf(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, ListOf[int]().IsEmpty())
+	require.True(test, ListOf(0, 1, 0).Equal(0, 1, 0))
+}
+
+func TestNewList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewList[int](2)
+	require.True(test, collection.IsEmpty())
+	require.Equal(test, 2, cap(collection))
+}
+
+func TestRangeList(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, RangeList(0, 5, 2).Equal(0, 2, 4))
+	require.True(test, RangeList(5, 0, -2).Equal(5, 3, 1))
+	require.True(test, RangeList(0.0, 1.0, 0.25).Equal(0, 0.25, 0.5, 0.75))
+	require.True(test, RangeList[uint](0, 3, 1).Equal(0, 1, 2))
+	require.True(test, RangeList(0, 5, 0).IsEmpty())
+	require.True(test, RangeList(5, 0, 1).IsEmpty())
+	require.True(test, RangeList[uint8](250, 255, 10).Equal(250))
+	require.True(test, RangeList[uint8](200, 255, 50).Equal(200, 250))
+	require.True(test, RangeList[uint8](5, 0, 255).IsEmpty())
+	require.True(test, RangeList[int8](0, 127, 100).Equal(0, 100))
+	require.True(test, RangeList[int8](0, -128, -100).Equal(0, -100))
+	require.True(test, RangeList[int8](-128, 127, 127).Equal(-128, -1, 126))
+	require.True(test, RangeList(1.0, 0.0, -0.5).Equal(1.0, 0.5))
+	require.True(test, RangeList(0.0, 1.0, math.NaN()).IsEmpty())
+	require.True(test, RangeList(0.0, 1.0, math.Inf(1)).IsEmpty())
+	require.True(test, RangeList(0.0, math.Inf(1), math.Inf(1)).IsEmpty())
+	require.True(test, RangeList(1e20, 2e20, 1.0).Equal(1e20))
+	require.True(test, RangeList(math.Inf(-1), 0.0, 1.0).Equal(math.Inf(-1)))
+}
+
+func TestReadJSONLines(test *testing.T) {
+	test.Parallel()
+
+	collection, err := ReadJSONLines[string](strings.NewReader("\"a\"\n\n\"b\"\n\"c\""))
+	require.NoError(test, err)
+	require.True(test, collection.Equal("a", "b", "c"))
+	collection, err = ReadJSONLines[string](strings.NewReader(""))
+	require.NoError(test, err)
+	require.NotNil(test, collection)
+	require.True(test, collection.IsEmpty())
+	_, err = ReadJSONLines[string](strings.NewReader("\"a\"\n0\n"))
+	require.ErrorContains(test, err, "line 2")
+}
+
+func TestReduceList(test *testing.T) {
+	test.Parallel()
+
+	visited := 0
+	total := ReduceList(ListOf(4, 3, 5, 1), 0, func(accumulator int, value int) (int, bool) {
+		visited++
+		if accumulator+value > 8 {
+			return accumulator, true
+		}
+		return accumulator + value, false
+	})
+	require.Equal(test, 7, total)
+	require.Equal(test, 3, visited)
+	require.Equal(test, "abc", ReduceList(ListOf('a', 'b', 'c'), "", func(accumulator string, value rune) (string, bool) {
+		return accumulator + string(value), false
+	}))
+	require.Equal(test, 10, ReduceList(ListOf[int](), 10, func(int, int) (int, bool) { return 0, true }))
+}
+
+func TestRepeat(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, Repeat("a", 3).Equal("a", "a", "a"))
+	require.True(test, Repeat("a", -1).IsEmpty())
+}
+
+func TestRoundRobin(test *testing.T) {
+	test.Parallel()
+
+	values := make([]string, 0)
+	RoundRobin(ListOf("a1", "a2"), ListOf("b1", "b2", "b3"))(func(value string) bool {
+		values = append(values, value)
+		return len(values) < 4
+	})
+	require.Equal(test, []string{"a1", "b1", "a2", "b2"}, values)
+}
+
+func TestScan(test *testing.T) {
+	test.Parallel()
+
+	sum := func(accumulator int, value int) int { return accumulator + value }
+	require.True(test, Scan(ListOf(1, 2, 3), 0, sum).Equal(1, 3, 6))
+	require.True(test, Scan(ListOf(1, 2), 10, sum).Equal(11, 13))
+	require.True(test, Scan(ListOf[int](), 0, sum).IsEmpty())
+	require.True(test, Scan(ListOf("a", "b"), 0, func(offset int, value string) int {
+		return offset + len(value)
+	}).Equal(1, 2))
+}
+
+func TestToMap(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf("a", "bc", "de")
+	elements := ToMap(collection, func(value string) int { return len(value) }, strings.ToUpper)
+	require.True(test, elements.Equal(map[int]string{1: "A", 2: "DE"}))
+}
+
+func TestToSet(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, ToSet(ListOf(0, 1, 0)).Equal(0, 1))
+}
+
+func TestTryMapList(test *testing.T) {
+	test.Parallel()
+
+	transform := func(value int) (string, error) {
+		if value < 0 {
+			return "", errNegative
+		}
+		return fmt.Sprint(value), nil
+	}
+	results, err := TryMapList(ListOf(0, 1), transform, true)
+	require.NoError(test, err)
+	require.True(test, results.Equal("0", "1"))
+	results, err = TryMapList(ListOf[int](), transform, true)
+	require.NoError(test, err)
+	require.True(test, results.IsEmpty())
+	results, err = TryMapList(ListOf(0, -1, 2, -3), transform, true)
+	require.ErrorIs(test, err, errNegative)
+	require.Equal(test, "index 1: negative", err.Error())
+	require.Nil(test, results)
+	_, err = TryMapList(ListOf(0, -1, 2, -3), transform, false)
+	require.ErrorIs(test, err, errNegative)
+	require.Equal(test, "index 1: negative\nindex 3: negative", err.Error())
+}
+
 func TestList_Add(test *testing.T) {
 	test.Parallel()
 
@@ -44,6 +310,62 @@ func TestList_AddAll(test *testing.T) {
 	require.True(test, collection.Equal(0, 1, 0, 1))
 }
 
+func TestList_AddFromChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0)
+	require.NoError(test, collection.AddFromChannel(context.Background(), ListOf(1, 2).ToChannel(context.Background())))
+	require.True(test, collection.Equal(0, 1, 2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(test, collection.AddFromChannel(ctx, make(chan int)), context.Canceled)
+}
+
+func TestList_AppendBatch(test *testing.T) {
+	test.Parallel()
+
+	var collection List[int]
+	collection.AppendBatch([]int{0, 1})
+	collection.AppendBatch(nil)
+	collection.AppendBatch([]int{2})
+	require.True(test, collection.Equal(0, 1, 2))
+}
+
+func TestList_AsReadOnly(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0)
+	view := collection.AsReadOnly()
+	require.True(test, collection.Add(1))
+	require.True(test, view.Equal(0, 1))
+}
+
+func TestList_AsSlice(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	values := collection.AsSlice()
+	values[0] = 2
+	require.True(test, collection.Equal(2, 1))
+}
+
+func TestList_At(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	current, err := collection.At(-1)
+	require.NoError(test, err)
+	require.Equal(test, 2, current)
+	current, err = collection.At(0)
+	require.NoError(test, err)
+	require.Equal(test, 0, current)
+	_, err = collection.At(-4)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.At(3)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
 func TestList_Clear(test *testing.T) {
 	test.Parallel()
 
@@ -55,6 +377,29 @@ func TestList_Clear(test *testing.T) {
 	require.False(test, collection.Clear())
 }
 
+func TestList_Clone(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	clone := collection.Clone()
+	require.NoError(test, clone.Set(0, 1))
+	require.True(test, collection.Equal(0, 1))
+	require.True(test, clone.Equal(1, 1))
+}
+
+func TestList_Compact(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf("a", "", "b", "")
+	require.True(test, collection.Compact())
+	require.True(test, collection.Equal("a", "b"))
+	require.False(test, collection.Compact())
+
+	pointers := List[*int]{nil, new(int)}
+	require.True(test, pointers.Compact())
+	require.Len(test, pointers, 1)
+}
+
 func TestList_Contains(test *testing.T) {
 	test.Parallel()
 
@@ -73,6 +418,59 @@ func TestList_ContainsAll(test *testing.T) {
 	require.True(test, collection.ContainsAll(0, 1))
 }
 
+func TestList_ContainsAny(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf([]int{0}, []int{1})
+	require.True(test, collection.ContainsAny([]int{2}, []int{1}))
+	require.False(test, collection.ContainsAny([]int{2}))
+	require.False(test, collection.ContainsAny())
+}
+
+func TestList_ContainsList(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3)
+	require.True(test, collection.ContainsList(nil))
+	require.True(test, collection.ContainsList(ListOf(1, 2)))
+	require.True(test, collection.ContainsList(ListOf(0, 1, 2, 3)))
+	require.False(test, collection.ContainsList(ListOf(1, 3)))
+	require.False(test, collection.ContainsList(ListOf(2, 3, 4)))
+}
+
+func TestList_Count(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 0)
+	require.Equal(test, 2, collection.Count(0))
+	require.Equal(test, 0, collection.Count(2))
+}
+
+func TestList_CountFunc(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3)
+	require.Equal(test, 2, collection.CountFunc(func(value int) bool { return value%2 == 1 }))
+}
+
+func TestList_DecodeJSON(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[int], 0)
+	require.Error(test, collection.DecodeJSON(json.NewDecoder(strings.NewReader(`{}`)), nil))
+	require.NoError(test, collection.DecodeJSON(json.NewDecoder(strings.NewReader(`[0, 1]`)), nil))
+	require.True(test, collection.Equal(0, 1))
+
+	count := 0
+	require.NoError(test, collection.DecodeJSON(json.NewDecoder(strings.NewReader(`[2, 3]`)), func(value int) bool {
+		require.Equal(test, 2, value)
+		count++
+		return false
+	}))
+	require.Equal(test, 1, count)
+	require.True(test, collection.Equal(0, 1, 2))
+}
+
 func TestList_Delete(test *testing.T) {
 	test.Parallel()
 
@@ -87,6 +485,64 @@ func TestList_Delete(test *testing.T) {
 	require.Equal(test, 1, previous)
 }
 
+func TestList_DeleteAllIndexes(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	require.ErrorIs(test, collection.DeleteAllIndexes(0, 5), ErrIndexOutOfRange)
+	require.True(test, collection.Equal(0, 1, 2, 3, 4))
+	require.NoError(test, collection.DeleteAllIndexes(3, 0, 3))
+	require.True(test, collection.Equal(1, 2, 4))
+	require.NoError(test, collection.DeleteAllIndexes())
+	require.True(test, collection.Equal(1, 2, 4))
+}
+
+func TestList_DeleteAt(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	previous, err := collection.DeleteAt(-1)
+	require.NoError(test, err)
+	require.Equal(test, 2, previous)
+	previous, err = collection.DeleteAt(-2)
+	require.NoError(test, err)
+	require.Equal(test, 0, previous)
+	_, err = collection.DeleteAt(-2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	require.True(test, collection.Equal(1))
+}
+
+func TestList_DeleteRange(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	removed, err := collection.DeleteRange(1, 3)
+	require.NoError(test, err)
+	require.Equal(test, []int{1, 2}, removed)
+	require.True(test, collection.Equal(0, 3, 4))
+
+	removed, err = collection.DeleteRange(2, 1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	require.Nil(test, removed)
+	_, err = collection.DeleteRange(0, 4)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+
+	removed, err = collection.DeleteRange(0, 3)
+	require.NoError(test, err)
+	require.Equal(test, []int{0, 3, 4}, removed)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestList_EndsWith(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	require.True(test, collection.EndsWith(nil))
+	require.True(test, collection.EndsWith(ListOf(1, 2)))
+	require.False(test, collection.EndsWith(ListOf(0, 1)))
+	require.False(test, collection.EndsWith(ListOf(-1, 0, 1, 2)))
+}
+
 func TestList_Equal(test *testing.T) {
 	test.Parallel()
 
@@ -99,6 +555,47 @@ func TestList_Equal(test *testing.T) {
 	require.False(test, collection.Equal(1, 0))
 }
 
+func TestList_EqualFunc(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0.0, 1.0)
+	comparator := func(this float64, that float64) bool {
+		return math.Abs(this-that) < 0.01
+	}
+	require.True(test, collection.EqualFunc([]float64{0.001, 0.999}, comparator))
+	require.False(test, collection.EqualFunc([]float64{0.1, 1.0}, comparator))
+	require.False(test, collection.EqualFunc([]float64{0.0}, comparator))
+}
+
+func TestList_EqualList(test *testing.T) {
+	test.Parallel()
+
+	var collection List[int]
+	require.True(test, collection.EqualList(ListOf[int]()))
+	collection = ListOf(0, 1)
+	require.True(test, collection.EqualList(ListOf(0, 1)))
+	require.False(test, collection.EqualList(ListOf(1, 0)))
+	require.False(test, collection.EqualList(ListOf(0)))
+}
+
+func TestList_Fill(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	collection.Fill(3)
+	require.True(test, collection.Equal(3, 3, 3))
+}
+
+func TestList_First(test *testing.T) {
+	test.Parallel()
+
+	value, exists := ListOf(0, 1).First()
+	require.True(test, exists)
+	require.Equal(test, 0, value)
+	_, exists = List[int]{}.First()
+	require.False(test, exists)
+}
+
 func TestList_ForEach(test *testing.T) {
 	test.Parallel()
 
@@ -110,6 +607,100 @@ func TestList_ForEach(test *testing.T) {
 	})
 }
 
+func TestList_ForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.ForEachCtx(context.Background(), func(value int) bool {
+		count++
+		return value < 1
+	}))
+	require.Equal(test, 2, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := collection.ForEachCtx(ctx, func(value int) bool {
+		cancel()
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestList_ForEachErr(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.ForEachErr(func(value int) error {
+		count++
+		return nil
+	}))
+	require.Equal(test, 3, count)
+
+	count = 0
+	err := collection.ForEachErr(func(value int) error {
+		count++
+		return io.EOF
+	})
+	require.ErrorIs(test, err, io.EOF)
+	require.Equal(test, 1, count)
+}
+
+func TestList_ForEachSnapshot(test *testing.T) {
+	test.Parallel()
+
+	var lock sync.RWMutex
+	collection := ListOf(0, 1)
+	values := make([]int, 0)
+	collection.ForEachSnapshot(lock.RLocker(), func(value int) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		values = append(values, value)
+		collection[1] = 2
+		return true
+	})
+	require.Equal(test, []int{0, 1}, values)
+	require.Equal(test, List[int]{0, 2}, collection)
+
+	var group sync.WaitGroup
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for index := 0; index < 100; index++ {
+			lock.Lock()
+			collection.Add(index)
+			lock.Unlock()
+		}
+	}()
+	for index := 0; index < 10; index++ {
+		collection.ForEachSnapshot(lock.RLocker(), func(value int) bool { return true })
+	}
+	group.Wait()
+	require.Equal(test, 102, collection.Size())
+}
+
+func TestList_Format(test *testing.T) {
+	test.Parallel()
+
+	type element struct{ Name string }
+	collection := List[element]{{Name: "a"}}
+	require.Equal(test, "[{a}]", fmt.Sprintf("%v", collection))
+	require.Equal(test, "[{Name:a}]", fmt.Sprintf("%+v", collection))
+	require.Equal(test, "collection.List[int]{0, 1}", fmt.Sprintf("%#v", List[int]{0, 1}))
+	require.Equal(test, `  [0 1]`, fmt.Sprintf("%7s", List[int]{0, 1}))
+	require.Equal(test, `[01 10]`, fmt.Sprintf("%02d", List[int]{1, 10}))
+}
+
+func TestList_Generate(test *testing.T) {
+	test.Parallel()
+
+	require.NoError(test, quick.Check(func(collection List[int]) bool {
+		return collection.Equal(collection.Clone()...)
+	}, &quick.Config{MaxCount: 100, MaxCountScale: 0, Rand: nil, Values: nil}))
+	value := List[int]{}.Generate(rand.New(rand.NewSource(0)), 0)
+	require.Empty(test, value.Interface())
+}
+
 func TestList_Get(test *testing.T) {
 	test.Parallel()
 
@@ -162,6 +753,32 @@ func TestList_IsEmpty(test *testing.T) {
 	require.False(test, collection.IsEmpty())
 }
 
+func TestList_Join(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[int], 0)
+	require.Equal(test, "", collection.Join(", "))
+	require.True(test, collection.AddAll(0, 1, 2))
+	require.Equal(test, "0, 1, 2", collection.Join(", "))
+}
+
+func TestList_JoinFunc(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf("a", "b")
+	require.Equal(test, "'a','b'", collection.JoinFunc(",", func(value string) string { return "'" + value + "'" }))
+}
+
+func TestList_Last(test *testing.T) {
+	test.Parallel()
+
+	value, exists := ListOf(0, 1).Last()
+	require.True(test, exists)
+	require.Equal(test, 1, value)
+	_, exists = List[int]{}.Last()
+	require.False(test, exists)
+}
+
 func TestList_LastIndexOf(test *testing.T) {
 	test.Parallel()
 
@@ -171,6 +788,20 @@ func TestList_LastIndexOf(test *testing.T) {
 	require.Equal(test, 1, collection.LastIndexOf(0))
 }
 
+func TestList_MarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[string], 0)
+	require.True(test, collection.AddAll("a", "bc"))
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+	require.Equal(test, []byte{1, 2, 1, 'a', 2, 'b', 'c'}, data)
+
+	_, err = List[*int]{nil}.MarshalBinary()
+	require.ErrorIs(test, err, ErrUnsupportedType)
+}
+
 func TestList_MarshalJSON(test *testing.T) {
 	test.Parallel()
 
@@ -185,6 +816,167 @@ func TestList_MarshalJSON(test *testing.T) {
 	require.Equal(test, expected, data)
 }
 
+func TestList_MarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	var collection List[int]
+	data, err := collection.MarshalJSONWith(JSONOptions{})
+	require.NoError(test, err)
+	require.Equal(test, "[]", string(data))
+	data, err = collection.MarshalJSONWith(JSONOptions{NullEmpty: true})
+	require.NoError(test, err)
+	require.Equal(test, "null", string(data))
+	data, err = ListOf(1, 0).MarshalJSONWith(JSONOptions{NullEmpty: true})
+	require.NoError(test, err)
+	require.Equal(test, "[1,0]", string(data))
+}
+
+func TestList_MaxBy(test *testing.T) {
+	test.Parallel()
+
+	_, exists := ListOf[int]().MaxBy(lessInt)
+	require.False(test, exists)
+	collection := ListOf("b", "cc", "a", "dd")
+	value, exists := collection.MaxBy(func(this string, that string) bool { return len(this) < len(that) })
+	require.True(test, exists)
+	require.Equal(test, "cc", value)
+}
+
+func TestList_MinBy(test *testing.T) {
+	test.Parallel()
+
+	_, exists := ListOf[int]().MinBy(lessInt)
+	require.False(test, exists)
+	collection := ListOf("bb", "c", "a", "dd")
+	value, exists := collection.MinBy(func(this string, that string) bool { return len(this) < len(that) })
+	require.True(test, exists)
+	require.Equal(test, "c", value)
+}
+
+func TestList_Move(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3)
+	require.NoError(test, collection.Move(0, 2))
+	require.Equal(test, List[int]{1, 2, 0, 3}, collection)
+	require.NoError(test, collection.Move(3, 0))
+	require.Equal(test, List[int]{3, 1, 2, 0}, collection)
+	require.NoError(test, collection.Move(1, 1))
+	require.Equal(test, List[int]{3, 1, 2, 0}, collection)
+	require.ErrorIs(test, collection.Move(4, 0), ErrIndexOutOfRange)
+	require.ErrorIs(test, collection.Move(0, 4), ErrIndexOutOfRange)
+}
+
+func TestList_MoveRange(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4, 5)
+	require.NoError(test, collection.MoveRange(0, 2, 3))
+	require.Equal(test, List[int]{2, 3, 4, 0, 1, 5}, collection)
+	require.NoError(test, collection.MoveRange(3, 3, 0))
+	require.Equal(test, List[int]{0, 1, 5, 2, 3, 4}, collection)
+	require.NoError(test, collection.MoveRange(2, 0, 6))
+	require.ErrorIs(test, collection.MoveRange(5, 2, 0), ErrIndexOutOfRange)
+	require.ErrorIs(test, collection.MoveRange(0, 2, 5), ErrIndexOutOfRange)
+	require.ErrorIs(test, collection.MoveRange(-1, 1, 0), ErrIndexOutOfRange)
+	require.ErrorIs(test, collection.MoveRange(0, -1, 0), ErrIndexOutOfRange)
+}
+
+func TestList_NLargest(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(3, 1, 4, 1, 5, 9, 2, 6)
+	require.Equal(test, List[int]{9, 6, 5}, collection.NLargest(3, lessInt))
+	require.Equal(test, List[int]{9, 6, 5, 4, 3, 2, 1, 1}, collection.NLargest(10, lessInt))
+	require.Empty(test, collection.NLargest(0, lessInt))
+	require.Equal(test, List[int]{3, 1, 4, 1, 5, 9, 2, 6}, collection)
+}
+
+func TestList_NSmallest(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(3, 1, 4, 1, 5, 9, 2, 6)
+	require.Equal(test, List[int]{1, 1, 2}, collection.NSmallest(3, lessInt))
+	require.Empty(test, List[int]{}.NSmallest(3, lessInt))
+}
+
+func TestList_Page(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	values, err := collection.Page(0, 2)
+	require.NoError(test, err)
+	require.Equal(test, List[int]{0, 1}, values)
+	values, err = collection.Page(2, 2)
+	require.NoError(test, err)
+	require.Equal(test, List[int]{4}, values)
+	_, err = collection.Page(3, 2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.Page(-1, 2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.Page(0, 0)
+	require.ErrorIs(test, err, ErrInvalidPageSize)
+	values, err = List[int]{}.Page(0, 2)
+	require.NoError(test, err)
+	require.Empty(test, values)
+}
+
+func TestList_PageCount(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	require.Equal(test, 3, collection.PageCount(2))
+	require.Equal(test, 1, collection.PageCount(5))
+	require.Equal(test, 0, collection.PageCount(0))
+	require.Equal(test, 0, List[int]{}.PageCount(2))
+}
+
+func TestList_ParallelForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[int], 0)
+	for value := 0; value < 100; value++ {
+		require.True(test, collection.Add(value))
+	}
+	var sum atomic.Int64
+	collection.ParallelForEach(4, func(value int) bool {
+		sum.Add(int64(value))
+		return true
+	})
+	require.Equal(test, int64(4950), sum.Load())
+
+	var count atomic.Int64
+	collection.ParallelForEach(1, func(value int) bool {
+		return count.Add(1) < 10
+	})
+	require.Equal(test, int64(10), count.Load())
+
+	require.PanicsWithValue(test, "failure", func() {
+		collection.ParallelForEach(0, func(value int) bool {
+			panic("failure")
+		})
+	})
+}
+
+func TestList_ParallelForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3)
+	var count atomic.Int64
+	require.NoError(test, collection.ParallelForEachCtx(context.Background(), 2, func(value int) bool {
+		count.Add(1)
+		return true
+	}))
+	require.Equal(test, int64(4), count.Load())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := collection.ParallelForEachCtx(ctx, 2, func(value int) bool {
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
 func TestList_Partitions(test *testing.T) {
 	test.Parallel()
 
@@ -204,6 +996,64 @@ func TestList_Partitions(test *testing.T) {
 	})
 }
 
+func TestList_PartitionsCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.PartitionsCtx(context.Background(), 2, func(values []int) bool {
+		count++
+		return true
+	}))
+	require.Equal(test, 2, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := collection.PartitionsCtx(ctx, 1, func(values []int) bool {
+		cancel()
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestList_PopFirst(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	value, exists := collection.PopFirst()
+	require.True(test, exists)
+	require.Equal(test, 0, value)
+	require.Equal(test, List[int]{1}, collection)
+	_, _ = collection.PopFirst()
+	_, exists = collection.PopFirst()
+	require.False(test, exists)
+}
+
+func TestList_PopLast(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	value, exists := collection.PopLast()
+	require.True(test, exists)
+	require.Equal(test, 1, value)
+	require.Equal(test, List[int]{0}, collection)
+	_, _ = collection.PopLast()
+	_, exists = collection.PopLast()
+	require.False(test, exists)
+}
+
+func TestList_ReleaseToPool(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf("a", "b")
+	collection.ReleaseToPool()
+	require.Nil(test, collection)
+	require.True(test, collection.Add("c"))
+	require.True(test, collection.Equal("c"))
+
+	reused := NewList[string](1)
+	require.True(test, reused.IsEmpty())
+}
+
 func TestList_Remove(test *testing.T) {
 	test.Parallel()
 
@@ -245,6 +1095,19 @@ func TestList_Reverse(test *testing.T) {
 	require.True(test, collection.Equal(1, 0))
 }
 
+func TestList_Rotate(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	collection.Rotate(2)
+	require.True(test, collection.Equal(3, 4, 0, 1, 2))
+	collection.Rotate(-7)
+	require.True(test, collection.Equal(0, 1, 2, 3, 4))
+	collection.Rotate(5)
+	require.True(test, collection.Equal(0, 1, 2, 3, 4))
+	List[int]{}.Rotate(1)
+}
+
 func TestList_Set(test *testing.T) {
 	test.Parallel()
 
@@ -254,6 +1117,15 @@ func TestList_Set(test *testing.T) {
 	require.NoError(test, collection.Set(0, 0))
 }
 
+func TestList_SetAt(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	require.NoError(test, collection.SetAt(-2, 3))
+	require.ErrorIs(test, collection.SetAt(-4, 3), ErrIndexOutOfRange)
+	require.True(test, collection.Equal(0, 3, 2))
+}
+
 func TestList_Size(test *testing.T) {
 	test.Parallel()
 
@@ -279,6 +1151,30 @@ func TestList_Sort(test *testing.T) {
 	require.True(test, collection.Equal(0, 1))
 }
 
+func TestList_Split(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	segments := collection.Split(3)
+	require.Equal(test, []List[int]{{0, 1}, {2, 3}, {4}}, segments)
+	segments[0].Add(5)
+	require.Equal(test, List[int]{0, 1, 2, 3, 4}, collection)
+	segments[1][0] = 6
+	require.Equal(test, 6, collection[2])
+	require.Equal(test, []List[int]{{0}, {}}, ListOf(0).Split(2))
+	require.Empty(test, collection.Split(0))
+}
+
+func TestList_StartsWith(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	require.True(test, collection.StartsWith(nil))
+	require.True(test, collection.StartsWith(ListOf(0, 1)))
+	require.False(test, collection.StartsWith(ListOf(1, 2)))
+	require.False(test, collection.StartsWith(ListOf(0, 1, 2, 3)))
+}
+
 func TestList_String(test *testing.T) {
 	test.Parallel()
 
@@ -301,6 +1197,52 @@ func TestList_Swap(test *testing.T) {
 	require.Equal(test, 1, previous)
 }
 
+func TestList_SwapRanges(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2, 3, 4)
+	require.NoError(test, collection.SwapRanges(0, 3, 2))
+	require.True(test, collection.Equal(3, 4, 2, 0, 1))
+	require.ErrorIs(test, collection.SwapRanges(0, 4, 2), ErrIndexOutOfRange)
+	require.ErrorIs(test, collection.SwapRanges(0, 1, 2), ErrOverlappingRanges)
+	require.NoError(test, collection.SwapRanges(1, 1, 0))
+}
+
+func TestList_ToChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 2)
+	values := make([]int, 0)
+	for value := range collection.ToChannel(context.Background()) {
+		values = append(values, value)
+	}
+	require.Equal(test, []int{0, 1, 2}, values)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := collection.ToChannel(ctx)
+	require.Equal(test, 0, <-channel)
+	cancel()
+	for range channel {
+		continue
+	}
+}
+
+func TestList_UnmarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[float64], 0)
+	require.True(test, collection.AddAll(-1.5, 0, 2.25))
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+
+	result := make(List[float64], 0)
+	require.NoError(test, result.UnmarshalBinary(data))
+	require.True(test, result.Equal(-1.5, 0, 2.25))
+	require.ErrorIs(test, result.UnmarshalBinary(data[:len(data)-1]), io.ErrUnexpectedEOF)
+	require.ErrorIs(test, result.UnmarshalBinary([]byte{0, 0}), ErrUnsupportedVersion)
+}
+
 func TestList_UnmarshalJSON(test *testing.T) {
 	test.Parallel()
 
@@ -313,4 +1255,84 @@ func TestList_UnmarshalJSON(test *testing.T) {
 	err = json.Unmarshal(data, &collection)
 	require.NoError(test, err)
 	require.True(test, collection.Equal(0))
+
+	require.NoError(test, json.Unmarshal([]byte("null"), &collection))
+	require.NotNil(test, collection)
+	require.Empty(test, collection)
+}
+
+func TestList_UnmarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	type element struct {
+		Name string
+	}
+	var collection List[element]
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`[{"Name": "a", "Other": 0}]`), JSONOptions{}))
+	require.Equal(test, List[element]{{Name: "a"}}, collection)
+	options := JSONOptions{DisallowUnknownFields: true}
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`[{"Name": "b", "Other": 0}]`), options))
+	require.ErrorIs(test, collection.UnmarshalJSONWith([]byte(`[] []`), options), ErrUnexpectedToken)
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`[] [`), options))
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`{"Name": "b"}`), options))
+	require.Equal(test, List[element]{{Name: "a"}}, collection)
+
+	options = JSONOptions{AcceptScalar: true}
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(` {"Name": "b"}`), options))
+	require.Equal(test, List[element]{{Name: "b"}}, collection)
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`null`), options))
+	require.NotNil(test, collection)
+	require.Empty(test, collection)
+}
+
+func TestList_WeightedSample(test *testing.T) {
+	test.Parallel()
+
+	weights := func(value string) float64 { return map[string]float64{"a": 1, "b": 3, "c": 0, "d": -1}[value] }
+	random := rand.New(rand.NewSource(0))
+	counts := make(Map[string, int])
+	collection := ListOf("a", "b", "c", "d")
+	for index := 0; index < 4000; index++ {
+		value, exists := collection.WeightedSample(weights, random)
+		require.True(test, exists)
+		counts[value]++
+	}
+	require.Len(test, counts, 2)
+	require.InDelta(test, 3.0, float64(counts["b"])/float64(counts["a"]), 0.3)
+	_, exists := ListOf("c", "d").WeightedSample(weights, nil)
+	require.False(test, exists)
+	value, exists := ListOf("a").WeightedSample(weights, nil)
+	require.True(test, exists)
+	require.Equal(test, "a", value)
+}
+
+func TestList_WeightedShuffle(test *testing.T) {
+	test.Parallel()
+
+	weights := func(value int) float64 { return float64(value) }
+	random := rand.New(rand.NewSource(0))
+	firsts := make(Map[int, int])
+	for index := 0; index < 3000; index++ {
+		collection := ListOf(-1, 0, 1, 2)
+		collection.WeightedShuffle(weights, random)
+		require.Equal(test, []int{-1, 0}, []int(collection[2:]))
+		firsts[collection[0]]++
+	}
+	require.InDelta(test, 2.0, float64(firsts[2])/float64(firsts[1]), 0.3)
+	collection := ListOf(1, 2, 3)
+	collection.WeightedShuffle(weights, nil)
+	require.ElementsMatch(test, []int{1, 2, 3}, collection)
+}
+
+func TestList_WriteJSONLines(test *testing.T) {
+	test.Parallel()
+
+	var builder strings.Builder
+	collection := ListOf(map[string]int{"a": 0}, nil, map[string]int{"b": 1})
+	require.NoError(test, collection.WriteJSONLines(&builder))
+	require.Equal(test, "{\"a\":0}\nnull\n{\"b\":1}\n", builder.String())
+	restored, err := ReadJSONLines[map[string]int](strings.NewReader(builder.String()))
+	require.NoError(test, err)
+	require.Equal(test, collection, restored)
+	require.Error(test, ListOf(math.NaN()).WriteJSONLines(io.Discard))
 }
@@ -1,14 +1,264 @@
 package collection
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"reflect"
+	"sort"
+	"sync"
 )
 
-// Map represents an unordered collection that maps keys to values.
+var (
+	// ErrDuplicateKey indicates that a key occurred more than once.
+	ErrDuplicateKey = errors.New("duplicate key")
+	// ErrDuplicateValue indicates that a value occurred more than once.
+	ErrDuplicateValue = errors.New("duplicate value")
+)
+
+// Map represents an unordered collection that maps keys to values. The zero
+// value is an empty map that is initialized when an element is first added.
 type Map[Key comparable, Value any] map[Key]Value
 
+// Entry represents a key-value pair.
+type Entry[Key comparable, Value any] struct {
+	Key   Key
+	Value Value
+}
+
+// CollectMap returns a map containing the key-value pairs produced by the
+// specified sequence. Later pairs replace earlier pairs with the same key. The
+// sequence is compatible with iter.Seq2.
+func CollectMap[Key comparable, Value any](
+	sequence func(yield func(key Key, value Value) (next bool)),
+) (collection Map[Key, Value]) {
+	collection = make(Map[Key, Value])
+	sequence(func(key Key, value Value) bool {
+		collection[key] = value
+		return true
+	})
+	return collection
+}
+
+// FilterMap returns a map containing the elements of the specified map for
+// which the specified predicate returns true.
+func FilterMap[Key comparable, Value any](
+	collection Map[Key, Value], predicate func(key Key, value Value) (keep bool),
+) (filtered Map[Key, Value]) {
+	filtered = make(Map[Key, Value])
+	for key, value := range collection {
+		if predicate(key, value) {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// FoldMap combines the elements of the specified map, in an arbitrary order,
+// by applying the specified function to the result so far and each key and
+// value after the first, starting from the first element as an entry. Folding
+// stops early if the function returns true for stop. The result and true are
+// returned, or the zero value and false if the map is empty.
+func FoldMap[Key comparable, Value any](
+	collection Map[Key, Value],
+	fold func(accumulator Entry[Key, Value], key Key, value Value) (result Entry[Key, Value], stop bool),
+) (result Entry[Key, Value], exists bool) {
+	for key, value := range collection {
+		if !exists {
+			result, exists = Entry[Key, Value]{Key: key, Value: value}, true
+			continue
+		}
+		var stop bool
+		if result, stop = fold(result, key, value); stop {
+			break
+		}
+	}
+	return result, exists
+}
+
+// InnerJoin returns a map that associates each key contained in both of the
+// specified maps with the result of the specified function for the values of
+// that key in each map.
+func InnerJoin[Key comparable, Left any, Right any, Result any](
+	left Map[Key, Left], right Map[Key, Right], combine func(key Key, left Left, right Right) (result Result),
+) (joined Map[Key, Result]) {
+	joined = make(Map[Key, Result])
+	for key, value := range left {
+		if other, exists := right[key]; exists {
+			joined[key] = combine(key, value, other)
+		}
+	}
+	return joined
+}
+
+// InvertMap returns a map that maps each value of the specified map to its key.
+// If more than one key maps to the same value, an error is returned instead.
+func InvertMap[Key comparable, Value comparable](collection Map[Key, Value]) (inverted Map[Value, Key], err error) {
+	inverted = make(Map[Value, Key], len(collection))
+	for key, value := range collection {
+		if _, exists := inverted[value]; exists {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateValue, value)
+		}
+		inverted[value] = key
+	}
+	return inverted, nil
+}
+
+// LeftJoin returns a map that associates each key of the left map with the
+// result of the specified function for the values of that key in each map. If
+// the right map does not contain the key, the function receives the zero value
+// and false.
+func LeftJoin[Key comparable, Left any, Right any, Result any](
+	left Map[Key, Left], right Map[Key, Right],
+	combine func(key Key, left Left, right Right, exists bool) (result Result),
+) (joined Map[Key, Result]) {
+	joined = make(Map[Key, Result], len(left))
+	for key, value := range left {
+		other, exists := right[key]
+		joined[key] = combine(key, value, other, exists)
+	}
+	return joined
+}
+
+// MapFromPairs returns a map containing the specified key-value pairs. Later
+// pairs replace earlier pairs with the same key.
+func MapFromPairs[Key comparable, Value any](entries []Entry[Key, Value]) (collection Map[Key, Value]) {
+	collection = make(Map[Key, Value], len(entries))
+	for _, entry := range entries {
+		collection[entry.Key] = entry.Value
+	}
+	return collection
+}
+
+// MapFromStruct returns a map that associates the name of each exported field
+// of the specified struct, or pointer to struct, with the value of the field.
+// The name can be overridden by a "collection" struct tag, and a tag of "-"
+// skips the field.
+func MapFromStruct(value any) (collection Map[string, any], err error) {
+	current := reflect.Indirect(reflect.ValueOf(value))
+	if current.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, value)
+	}
+	collection = make(Map[string, any], current.NumField())
+	for index := 0; index < current.NumField(); index++ {
+		if name, skip := bindName(current.Type().Field(index)); !skip {
+			collection[name] = current.Field(index).Interface()
+		}
+	}
+	return collection, nil
+}
+
+// MapMapKeys returns a map that associates the values of the specified map with
+// the keys produced by the specified transform. If the transform produces the
+// same key more than once, an arbitrary one of the values is retained.
+func MapMapKeys[Key comparable, Value any, Result comparable](
+	collection Map[Key, Value], transform func(key Key, value Value) (result Result),
+) (transformed Map[Result, Value]) {
+	transformed = make(Map[Result, Value], len(collection))
+	for key, value := range collection {
+		transformed[transform(key, value)] = value
+	}
+	return transformed
+}
+
+// MapMapValues returns a map that associates the keys of the specified map with
+// the values produced by the specified transform.
+func MapMapValues[Key comparable, Value any, Result any](
+	collection Map[Key, Value], transform func(key Key, value Value) (result Result),
+) (transformed Map[Key, Result]) {
+	transformed = make(Map[Key, Result], len(collection))
+	for key, value := range collection {
+		transformed[key] = transform(key, value)
+	}
+	return transformed
+}
+
+// MapOf returns a map containing the specified key-value pairs. Later pairs
+// replace earlier pairs with the same key.
+func MapOf[Key comparable, Value any](entries ...Entry[Key, Value]) (collection Map[Key, Value]) {
+	return MapFromPairs(entries)
+}
+
+// NewMap returns an empty map with the specified initial capacity, reusing
+// storage released by ReleaseToPool if available.
+func NewMap[Key comparable, Value any](capacity int) (collection Map[Key, Value]) {
+	return newMap[Key, Value](capacity)
+}
+
+// ReduceMap combines the elements of the specified map, in an arbitrary order,
+// by applying the specified function to the result so far and each key and
+// value, starting from the specified initial result. Reducing stops early if
+// the function returns true for stop, and the result returned with it is the
+// final result.
+func ReduceMap[Key comparable, Value any, Result any](
+	collection Map[Key, Value], initial Result,
+	reduce func(accumulator Result, key Key, value Value) (result Result, stop bool),
+) (result Result) {
+	result = initial
+	for key, value := range collection {
+		var stop bool
+		if result, stop = reduce(result, key, value); stop {
+			break
+		}
+	}
+	return result
+}
+
+// AddFromChannel associates the values received from the specified channel with
+// their keys in the map until the channel is closed or the context is done, in
+// which case the context error is returned.
+func (collection *Map[Key, Value]) AddFromChannel(ctx context.Context, channel <-chan Entry[Key, Value]) (err error) {
+	collection.initialize()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, open := <-channel:
+			if !open {
+				return nil
+			}
+			(*collection)[entry.Key] = entry.Value
+		}
+	}
+}
+
+// AsMap returns the elements of the map as a built-in map without copying
+// them. Changes to the built-in map are visible in the map and vice versa.
+func (collection Map[Key, Value]) AsMap() (elements map[Key]Value) {
+	return collection
+}
+
+// AsReadOnly returns a read-only view of the map.
+func (collection *Map[Key, Value]) AsReadOnly() (view UnmodifiableMap[Key, Value]) {
+	return UnmodifiableMap[Key, Value]{collection: collection}
+}
+
+// Bind sets the fields of the specified pointer to struct to the values of the
+// map with matching keys, which must be strings. Keys match the name of each
+// exported field, or its "collection" struct tag, preferring exact matches to
+// case-insensitive matches. Values are converted between numeric types and
+// between string types, and maps with string keys are bound to nested
+// structs. Numbers that would overflow the field type or lose a fractional
+// part are rejected with ErrUnsupportedType. If the specified coercion
+// function is not nil, it transforms each value before it is assigned to a
+// field of the specified type.
+func (collection Map[Key, Value]) Bind(
+	target any, coerce func(value any, kind reflect.Type) (result any, err error),
+) (err error) {
+	current := reflect.ValueOf(target)
+	if current.Kind() != reflect.Pointer || current.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %T", ErrUnsupportedType, target)
+	}
+	elements := reflect.ValueOf(map[Key]Value(collection))
+	if elements.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%w: %v", ErrUnsupportedType, elements.Type())
+	}
+	return bindStruct(elements, current.Elem(), coerce)
+}
+
 // Clear removes all of the elements from the map.
 func (collection *Map[Key, Value]) Clear() (modified bool) {
 	modified = len(*collection) > 0
@@ -16,6 +266,11 @@ func (collection *Map[Key, Value]) Clear() (modified bool) {
 	return modified
 }
 
+// Clone returns a copy of the map.
+func (collection Map[Key, Value]) Clone() (clone Map[Key, Value]) {
+	return collection.Map()
+}
+
 // ContainsAll returns true if the map contains all of the specified elements.
 // This method uses reflection to test equality.
 func (collection Map[Key, Value]) ContainsAll(elements map[Key]Value) (contains bool) {
@@ -29,6 +284,17 @@ func (collection Map[Key, Value]) ContainsAll(elements map[Key]Value) (contains
 	return true
 }
 
+// ContainsAnyKey returns true if the map contains at least one of the specified
+// keys.
+func (collection Map[Key, Value]) ContainsAnyKey(keys ...Key) (contains bool) {
+	for _, key := range keys {
+		if _, contains = collection[key]; contains {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsKey returns true if the map contains the specified key.
 func (collection Map[Key, Value]) ContainsKey(key Key) (contains bool) {
 	_, contains = collection[key]
@@ -46,12 +312,91 @@ func (collection Map[Key, Value]) ContainsValue(value Value) (contains bool) {
 	return false
 }
 
+// CountValues returns the number of keys associated with the specified value
+// in the map. This method uses reflection to test equality.
+func (collection Map[Key, Value]) CountValues(value Value) (count int) {
+	for _, current := range collection {
+		if reflect.DeepEqual(current, value) {
+			count++
+		}
+	}
+	return count
+}
+
+// DecodeJSON adds the elements of a JSON object read from the specified decoder
+// to the map, one element at a time. If the specified action is not nil, it is
+// performed for each element after it has been added, and decoding stops early
+// if the action returns false.
+func (collection *Map[Key, Value]) DecodeJSON(
+	decoder *json.Decoder, action func(key Key, value Value) (next bool),
+) (err error) {
+	token, err := decoder.Token()
+	if err != nil || token == nil {
+		return err
+	} else if delim, valid := token.(json.Delim); !valid || delim != '{' {
+		return fmt.Errorf("%w: %v", ErrUnexpectedToken, token)
+	}
+	collection.initialize()
+	for decoder.More() {
+		if token, err = decoder.Token(); err != nil {
+			return err
+		}
+		var key Key
+		var value Value
+		if key, err = decodeKey[Key](token); err != nil {
+			return err
+		} else if err = decoder.Decode(&value); err != nil {
+			return err
+		}
+		(*collection)[key] = value
+		if action != nil && !action(key, value) {
+			return nil
+		}
+	}
+	_, err = decoder.Token()
+	return err
+}
+
 // Equal compares the map to the specified elements for equality. This method
 // uses reflection to test equality.
 func (collection Map[Key, Value]) Equal(elements map[Key]Value) (equal bool) {
 	return reflect.DeepEqual(map[Key]Value(collection), elements)
 }
 
+// EqualFunc compares the map to the specified elements for equality, using the
+// specified comparator to test the equality of the values of each key.
+func (collection Map[Key, Value]) EqualFunc(
+	elements map[Key]Value, comparator func(this Value, that Value) (equal bool),
+) (equal bool) {
+	if len(collection) != len(elements) {
+		return false
+	}
+	for key, value := range collection {
+		if element, contains := elements[key]; !contains || !comparator(value, element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a map containing the elements of the map for which the
+// specified predicate returns true.
+func (collection Map[Key, Value]) Filter(predicate func(key Key, value Value) (keep bool)) (filtered Map[Key, Value]) {
+	return FilterMap(collection, predicate)
+}
+
+// FilterInPlace removes all elements of the map for which the specified
+// predicate returns false, returning the number of elements removed.
+func (collection Map[Key, Value]) FilterInPlace(predicate func(key Key, value Value) (keep bool)) (removed int) {
+	for key, value := range collection {
+		if !predicate(key, value) {
+			delete(collection, key)
+			removed++
+		}
+	}
+	return removed
+}
+
 // ForEach performs the specified action for each element of the map until all
 // elements have been processed or the action returns false.
 func (collection Map[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) {
@@ -62,12 +407,92 @@ func (collection Map[Key, Value]) ForEach(action func(key Key, value Value) (nex
 	}
 }
 
+// ForEachCtx performs the specified action for each element of the map until
+// all elements have been processed, the action returns false, or the context is
+// done, in which case the context error is returned.
+func (collection Map[Key, Value]) ForEachCtx(
+	ctx context.Context, action func(key Key, value Value) (next bool),
+) (err error) {
+	for key, value := range collection {
+		if err = ctx.Err(); err != nil {
+			return err
+		} else if !action(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ForEachErr performs the specified action for each element of the map until
+// all elements have been processed or the action returns an error, which is
+// returned.
+func (collection Map[Key, Value]) ForEachErr(action func(key Key, value Value) (err error)) (err error) {
+	for key, value := range collection {
+		if err = action(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachSnapshot performs the specified action for each element of a copy of
+// the map, taken while holding the specified lock, until all elements have been
+// processed or the action returns false. The lock is released before the
+// action is called, so the action may modify the map.
+func (collection *Map[Key, Value]) ForEachSnapshot(lock sync.Locker, action func(key Key, value Value) (next bool)) {
+	lock.Lock()
+	snapshot := collection.Clone()
+	lock.Unlock()
+	snapshot.ForEach(action)
+}
+
+// ForEachSorted performs the specified action for each element of the map, in
+// the order of the keys induced by the specified comparator, until all
+// elements have been processed or the action returns false.
+func (collection Map[Key, Value]) ForEachSorted(
+	comparator func(this Key, that Key) (less bool), action func(key Key, value Value) (next bool),
+) {
+	for _, key := range collection.SortedKeys(comparator) {
+		if !action(key, collection[key]) {
+			return
+		}
+	}
+}
+
+// Format implements fmt.Formatter. The %#v verb prints a Go-syntax
+// representation of the map, and the %+v verb includes struct field names.
+func (collection Map[Key, Value]) Format(state fmt.State, verb rune) {
+	formatCollection(state, verb, collection, map[Key]Value(collection), map[Key]Value(collection))
+}
+
+// Generate implements quick.Generator, returning a random map of up to the
+// specified number of elements.
+func (collection Map[Key, Value]) Generate(random *rand.Rand, size int) (value reflect.Value) {
+	elements := make(Map[Key, Value])
+	generateValues(random, size, func(element Entry[Key, Value]) {
+		elements[element.Key] = element.Value
+	})
+	return reflect.ValueOf(elements)
+}
+
 // Get returns the value associated with the specified key, or the zero value
 // if the map does not contain the specified key.
 func (collection Map[Key, Value]) Get(key Key) (current Value) {
 	return collection[key]
 }
 
+// GetMany returns a map containing the elements of the map with the specified
+// keys. Keys that the map does not contain are omitted.
+func (collection Map[Key, Value]) GetMany(keys ...Key) (elements Map[Key, Value]) {
+	elements = make(Map[Key, Value], len(keys))
+	for _, key := range keys {
+		if value, exists := collection[key]; exists {
+			elements[key] = value
+		}
+	}
+	return elements
+}
+
 // GetOrDefault returns the value associated with the specified key, or the
 // specified value if the map does not contain the specified key.
 func (collection Map[Key, Value]) GetOrDefault(key Key, value Value) (current Value) {
@@ -78,11 +503,32 @@ func (collection Map[Key, Value]) GetOrDefault(key Key, value Value) (current Va
 	return current
 }
 
+// GetOrPut returns the value associated with the specified key and true if the
+// map contains the specified key. Otherwise, it associates the specified value
+// with the key and returns the specified value and false.
+func (collection *Map[Key, Value]) GetOrPut(key Key, value Value) (current Value, loaded bool) {
+	if current, loaded = (*collection)[key]; loaded {
+		return current, true
+	}
+	collection.initialize()
+	(*collection)[key] = value
+	return value, false
+}
+
 // IsEmpty returns true if the map contains no elements.
 func (collection Map[Key, Value]) IsEmpty() (empty bool) {
 	return len(collection) == 0
 }
 
+// KeySet returns a set containing the keys contained in the map.
+func (collection Map[Key, Value]) KeySet() (keys Set[Key]) {
+	keys = make(Set[Key], len(collection))
+	for key := range collection {
+		keys[key] = struct{}{}
+	}
+	return keys
+}
+
 // Keys returns the keys contained in the map.
 func (collection Map[Key, Value]) Keys() (keys []Key) {
 	keys = make([]Key, 0, len(collection))
@@ -92,7 +538,46 @@ func (collection Map[Key, Value]) Keys() (keys []Key) {
 	return keys
 }
 
-// Map returns a map containing all of the elements in the map.
+// LoadFrom associates each of the specified keys that the map does not contain
+// with the value returned by the specified fetch function, calling it
+// concurrently using the specified number of goroutines. If the number of
+// goroutines is less than one, GOMAXPROCS goroutines are used. Keys for which
+// the fetch function returns an error are not added, and the errors are joined
+// in key order. If the fetch function panics, the panic is propagated to the
+// caller.
+func (collection *Map[Key, Value]) LoadFrom(
+	keys []Key, fetch func(key Key) (value Value, err error), workers int,
+) (err error) {
+	missing := make([]Key, 0, len(keys))
+	seen := make(Set[Key], len(keys))
+	for _, key := range keys {
+		if _, exists := (*collection)[key]; !exists && seen.Add(key) {
+			missing = append(missing, key)
+		}
+	}
+	values := make([]Value, len(missing))
+	errs := make([]error, len(missing))
+	_ = parallelForEach(context.Background(), workers, func(yield func(index int) (next bool)) {
+		for index := range missing {
+			if !yield(index) {
+				return
+			}
+		}
+	}, func(index int) bool {
+		values[index], errs[index] = fetch(missing[index])
+		return true
+	})
+	collection.initialize()
+	for index, key := range missing {
+		if errs[index] == nil {
+			(*collection)[key] = values[index]
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Map returns a map containing all of the elements in the map. The map is a
+// copy; use AsMap to avoid copying.
 func (collection Map[Key, Value]) Map() (elements map[Key]Value) {
 	elements = make(map[Key]Value, len(collection))
 	for key, value := range collection {
@@ -101,24 +586,155 @@ func (collection Map[Key, Value]) Map() (elements map[Key]Value) {
 	return elements
 }
 
+// MarshalBinary returns a compact binary representation of the map. Only maps
+// of strings and fixed-size keys and values are supported.
+func (collection Map[Key, Value]) MarshalBinary() (elements []byte, err error) {
+	elements = appendBinaryHeader(make([]byte, 0), len(collection))
+	for key, value := range collection {
+		if elements, err = appendBinary(elements, reflect.ValueOf(&key).Elem()); err != nil {
+			return nil, err
+		} else if elements, err = appendBinary(elements, reflect.ValueOf(&value).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return elements, nil
+}
+
 // MarshalJSON returns a byte representation of the map.
 func (collection Map[Key, Value]) MarshalJSON() (elements []byte, err error) {
 	return json.Marshal(map[Key]Value(collection))
 }
 
+// MarshalJSONWith returns a byte representation of the map using the specified
+// options.
+func (collection Map[Key, Value]) MarshalJSONWith(options JSONOptions) (elements []byte, err error) {
+	if len(collection) == 0 {
+		return options.marshalEmpty("{}"), nil
+	}
+	return json.Marshal(map[Key]Value(collection))
+}
+
+// MaxBy returns the element of the map with the largest value according to
+// the specified comparator and true, or zero values and false if the map is
+// empty. If more than one value is largest, an arbitrary one is returned.
+func (collection Map[Key, Value]) MaxBy(
+	comparator func(this Value, that Value) (less bool),
+) (key Key, value Value, exists bool) {
+	for current, element := range collection {
+		if !exists || comparator(value, element) {
+			key, value, exists = current, element, true
+		}
+	}
+	return key, value, exists
+}
+
+// MinBy returns the element of the map with the smallest value according to
+// the specified comparator and true, or zero values and false if the map is
+// empty. If more than one value is smallest, an arbitrary one is returned.
+func (collection Map[Key, Value]) MinBy(
+	comparator func(this Value, that Value) (less bool),
+) (key Key, value Value, exists bool) {
+	for current, element := range collection {
+		if !exists || comparator(element, value) {
+			key, value, exists = current, element, true
+		}
+	}
+	return key, value, exists
+}
+
+// Page returns the elements on the specified page of the map, in the order of
+// the keys induced by the specified comparator, where pages are numbered from
+// zero and contain the specified number of elements. Only the keys up to the
+// end of the page are sorted, so earlier pages are cheaper to compute.
+func (collection Map[Key, Value]) Page(
+	comparator func(this Key, that Key) (less bool), page int, size int,
+) (elements List[Entry[Key, Value]], err error) {
+	if size <= 0 {
+		return nil, ErrInvalidPageSize
+	} else if page < 0 || (page > 0 && page >= (len(collection)+size-1)/size) {
+		return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, page)
+	}
+	end := len(collection)
+	if (page+1)*size < end {
+		end = (page + 1) * size
+	}
+	keys := List[Key](collection.Keys()).NSmallest(end, comparator)
+	elements = make(List[Entry[Key, Value]], 0, end-page*size)
+	for _, key := range keys[page*size:] {
+		elements = append(elements, Entry[Key, Value]{Key: key, Value: collection[key]})
+	}
+	return elements, nil
+}
+
+// ParallelForEach performs the specified action for each element of the map
+// using the specified number of goroutines, until all elements have been
+// processed or the action returns false. If the number of goroutines is less
+// than one, GOMAXPROCS goroutines are used. If the action panics, the panic is
+// propagated to the caller.
+func (collection Map[Key, Value]) ParallelForEach(workers int, action func(key Key, value Value) (next bool)) {
+	_ = collection.ParallelForEachCtx(context.Background(), workers, action)
+}
+
+// ParallelForEachCtx performs the specified action for each element of the map
+// using the specified number of goroutines, until all elements have been
+// processed, the action returns false, or the context is done, in which case
+// the context error is returned. If the number of goroutines is less than one,
+// GOMAXPROCS goroutines are used. If the action panics, the panic is
+// propagated to the caller.
+func (collection Map[Key, Value]) ParallelForEachCtx(
+	ctx context.Context, workers int, action func(key Key, value Value) (next bool),
+) (err error) {
+	return parallelForEach(ctx, workers, func(yield func(entry Entry[Key, Value]) bool) {
+		collection.ForEach(func(key Key, value Value) bool {
+			return yield(Entry[Key, Value]{Key: key, Value: value})
+		})
+	}, func(entry Entry[Key, Value]) bool {
+		return action(entry.Key, entry.Value)
+	})
+}
+
+// Pop removes the specified key from the map, returning the previous value and
+// true if the map contained the specified key.
+func (collection Map[Key, Value]) Pop(key Key) (previous Value, existed bool) {
+	previous, existed = collection[key]
+	delete(collection, key)
+	return previous, existed
+}
+
 // Put associates the specified value with the specified key in the map.
-func (collection Map[Key, Value]) Put(key Key, value Value) {
-	collection[key] = value
+func (collection *Map[Key, Value]) Put(key Key, value Value) {
+	collection.initialize()
+	(*collection)[key] = value
 }
 
 // PutAll associates all of the specified values with the specified keys in the
 // map.
-func (collection Map[Key, Value]) PutAll(elements map[Key]Value) {
+func (collection *Map[Key, Value]) PutAll(elements map[Key]Value) {
+	collection.initialize()
 	for key, value := range elements {
-		collection[key] = value
+		(*collection)[key] = value
 	}
 }
 
+// PutPairs associates all of the specified values with the specified keys in
+// the map. Later pairs replace earlier pairs with the same key.
+func (collection *Map[Key, Value]) PutPairs(entries ...Entry[Key, Value]) {
+	collection.initialize()
+	for _, entry := range entries {
+		(*collection)[entry.Key] = entry.Value
+	}
+}
+
+// ReleaseToPool removes all of the elements from the map and releases its
+// storage for reuse by NewMap. The storage must not be used through any other
+// reference to the map after it has been released.
+func (collection *Map[Key, Value]) ReleaseToPool() {
+	if *collection != nil {
+		releaseMap(map[Key]Value(*collection))
+	}
+	*collection = nil
+}
+
 // Remove removes the specified key from the map, returning the previous value.
 func (collection Map[Key, Value]) Remove(key Key) (previous Value) {
 	previous = collection[key]
@@ -126,11 +742,33 @@ func (collection Map[Key, Value]) Remove(key Key) (previous Value) {
 	return previous
 }
 
+// RemoveMany removes all of the specified keys from the map, returning the
+// number of keys that were removed.
+func (collection Map[Key, Value]) RemoveMany(keys ...Key) (removed int) {
+	for _, key := range keys {
+		if _, exists := collection[key]; exists {
+			delete(collection, key)
+			removed++
+		}
+	}
+	return removed
+}
+
 // Size returns the number of elements in the map.
 func (collection Map[Key, Value]) Size() (size int) {
 	return len(collection)
 }
 
+// SortedKeys returns the keys contained in the map, in the order induced by
+// the specified comparator.
+func (collection Map[Key, Value]) SortedKeys(comparator func(this Key, that Key) (less bool)) (keys []Key) {
+	keys = collection.Keys()
+	sort.Slice(keys, func(index, jndex int) bool {
+		return comparator(keys[index], keys[jndex])
+	})
+	return keys
+}
+
 // String returns a string representation of the map.
 func (collection Map[Key, Value]) String() (elements string) {
 	return fmt.Sprint(map[Key]Value(collection))
@@ -138,12 +776,49 @@ func (collection Map[Key, Value]) String() (elements string) {
 
 // Swap associates the specified value with the specified key in the map,
 // returning the previous value.
-func (collection Map[Key, Value]) Swap(key Key, value Value) (previous Value) {
-	previous = collection[key]
-	collection[key] = value
+func (collection *Map[Key, Value]) Swap(key Key, value Value) (previous Value) {
+	collection.initialize()
+	previous = (*collection)[key]
+	(*collection)[key] = value
 	return previous
 }
 
+// ToChannel returns a channel that receives each element of the map. The
+// elements are copied when this method is called, and the channel is closed
+// once all elements have been sent or the context is done.
+func (collection Map[Key, Value]) ToChannel(ctx context.Context) (channel <-chan Entry[Key, Value]) {
+	entries := make([]Entry[Key, Value], 0, len(collection))
+	for key, value := range collection {
+		entries = append(entries, Entry[Key, Value]{Key: key, Value: value})
+	}
+	return sendAll(ctx, entries)
+}
+
+// UnmarshalBinary replaces all of the map's elements with the specified binary
+// representation.
+func (collection *Map[Key, Value]) UnmarshalBinary(elements []byte) (err error) {
+	reader := bytes.NewReader(elements)
+	size, empty, err := readBinaryHeader(reader, reflect.TypeOf((*Key)(nil)).Elem(), reflect.TypeOf((*Value)(nil)).Elem())
+	if err != nil {
+		return err
+	} else if empty && size > 1 {
+		size = 1
+	}
+	buffer := make(map[Key]Value, size)
+	for index := 0; index < size; index++ {
+		var key Key
+		var value Value
+		if err = readBinary(reader, reflect.ValueOf(&key).Elem()); err != nil {
+			return err
+		} else if err = readBinary(reader, reflect.ValueOf(&value).Elem()); err != nil {
+			return err
+		}
+		buffer[key] = value
+	}
+	*collection = buffer
+	return nil
+}
+
 // UnmarshalJSON replaces all of the map's elements with the specified elements.
 func (collection *Map[Key, Value]) UnmarshalJSON(elements []byte) (err error) {
 	collection.Clear()
@@ -151,6 +826,37 @@ func (collection *Map[Key, Value]) UnmarshalJSON(elements []byte) (err error) {
 	return err
 }
 
+// UnmarshalJSONWith replaces all of the map's elements with the specified
+// elements using the specified options.
+func (collection *Map[Key, Value]) UnmarshalJSONWith(elements []byte, options JSONOptions) (err error) {
+	decoder := options.newDecoder(elements)
+	buffer := make(Map[Key, Value])
+	count := 0
+	var duplicate error
+	err = buffer.DecodeJSON(decoder, func(key Key, _ Value) bool {
+		count++
+		if options.DisallowDuplicates && len(buffer) != count {
+			duplicate = fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	} else if duplicate != nil {
+		return duplicate
+	} else if err = finishJSON(decoder); err != nil {
+		return err
+	}
+	*collection = buffer
+	return nil
+}
+
+// ValueList returns a list containing the values contained in the map.
+func (collection Map[Key, Value]) ValueList() (values List[Value]) {
+	return List[Value](collection.Values())
+}
+
 // Values returns the values contained in this map.
 func (collection Map[Key, Value]) Values() (values []Value) {
 	values = make([]Value, 0, len(collection))
@@ -159,3 +865,31 @@ func (collection Map[Key, Value]) Values() (values []Value) {
 	}
 	return values
 }
+
+// initialize makes the zero value of the map usable for adding elements.
+func (collection *Map[Key, Value]) initialize() {
+	if *collection == nil {
+		*collection = make(map[Key]Value)
+	}
+}
+
+// decodeKey converts the specified JSON object key token to a map key, using
+// the same rules as the json package.
+func decodeKey[Key comparable](token json.Token) (key Key, err error) {
+	text, valid := token.(string)
+	if !valid {
+		return key, fmt.Errorf("%w: %v", ErrUnexpectedToken, token)
+	}
+	data, err := json.Marshal(map[string]struct{}{text: {}})
+	if err != nil {
+		return key, err
+	}
+	buffer := make(map[Key]struct{}, 1)
+	if err = json.Unmarshal(data, &buffer); err != nil {
+		return key, err
+	}
+	for key = range buffer {
+		break
+	}
+	return key, nil
+}
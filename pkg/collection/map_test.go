@@ -2,10 +2,20 @@ package collection
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"reflect"
 	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/quick"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -28,6 +38,256 @@ func ExampleMap() {
 	// Output: [0=0 1=1]
 }
 
+func TestCollectMap(test *testing.T) {
+	test.Parallel()
+
+	collection := CollectMap(func(yield func(int, string) bool) {
+		_ = yield(0, "a") && yield(1, "b")
+	})
+	require.True(test, collection.Equal(map[int]string{0: "a", 1: "b"}))
+}
+
+func TestFilterMap(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	filtered := FilterMap(collection, func(key, value int) bool { return key != 1 })
+	require.True(test, filtered.Equal(map[int]int{0: 0, 2: 2}))
+	require.Equal(test, 3, collection.Size())
+}
+
+func TestFoldMap(test *testing.T) {
+	test.Parallel()
+
+	maximum := func(accumulator Entry[string, int], key string, value int) (Entry[string, int], bool) {
+		if value > accumulator.Value {
+			return Entry[string, int]{Key: key, Value: value}, false
+		}
+		return accumulator, false
+	}
+	result, exists := FoldMap(Map[string, int]{"a": 3, "b": 7, "c": 2}, maximum)
+	require.True(test, exists)
+	require.Equal(test, Entry[string, int]{Key: "b", Value: 7}, result)
+	_, exists = FoldMap(Map[string, int]{}, maximum)
+	require.False(test, exists)
+	calls := 0
+	result, _ = FoldMap(Map[string, int]{"a": 1, "b": 1, "c": 1, "d": 1}, func(
+		accumulator Entry[string, int], key string, value int,
+	) (Entry[string, int], bool) {
+		calls++
+		return Entry[string, int]{Key: key, Value: accumulator.Value + value}, true
+	})
+	require.Equal(test, 1, calls)
+	require.Equal(test, 2, result.Value)
+}
+
+func TestInnerJoin(test *testing.T) {
+	test.Parallel()
+
+	left := Map[int, string]{0: "a", 1: "b"}
+	right := Map[int, int]{1: 2, 2: 3}
+	joined := InnerJoin(left, right, func(key int, left string, right int) string {
+		return fmt.Sprint(key, left, right)
+	})
+	require.Equal(test, Map[int, string]{1: "1b2"}, joined)
+}
+
+func TestInvertMap(test *testing.T) {
+	test.Parallel()
+
+	inverted, err := InvertMap(Map[int, string]{0: "a", 1: "b"})
+	require.NoError(test, err)
+	require.True(test, inverted.Equal(map[string]int{"a": 0, "b": 1}))
+
+	inverted, err = InvertMap(Map[int, string]{0: "a", 1: "a"})
+	require.ErrorIs(test, err, ErrDuplicateValue)
+	require.Nil(test, inverted)
+}
+
+func TestLeftJoin(test *testing.T) {
+	test.Parallel()
+
+	left := Map[int, string]{0: "a", 1: "b"}
+	right := Map[int, int]{1: 2, 2: 3}
+	joined := LeftJoin(left, right, func(key int, left string, right int, exists bool) string {
+		return fmt.Sprint(key, left, right, exists)
+	})
+	require.Equal(test, Map[int, string]{0: "0a0 false", 1: "1b2 true"}, joined)
+}
+
+func TestMapFromPairs(test *testing.T) {
+	test.Parallel()
+
+	collection := MapFromPairs([]Entry[int, int]{{Key: 0, Value: 0}, {Key: 1, Value: 1}, {Key: 0, Value: 2}})
+	require.True(test, collection.Equal(map[int]int{0: 2, 1: 1}))
+}
+
+func TestMapFromStruct(test *testing.T) {
+	test.Parallel()
+
+	type config struct {
+		Name    string
+		Port    int    `collection:"port"`
+		Secret  string `collection:"-"`
+		private int
+	}
+	collection, err := MapFromStruct(&config{Name: "a", Port: 1, Secret: "b", private: 2})
+	require.NoError(test, err)
+	require.Equal(test, Map[string, any]{"Name": "a", "port": 1}, collection)
+	_, err = MapFromStruct(0)
+	require.ErrorIs(test, err, ErrUnsupportedType)
+}
+
+func TestMapMapKeys(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[string, int]{"A": 0, "b": 1}
+	transformed := MapMapKeys(collection, func(key string, value int) string { return strings.ToLower(key) })
+	require.True(test, transformed.Equal(map[string]int{"a": 0, "b": 1}))
+}
+
+func TestMapMapValues(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[string, int]{"a": 0, "b": 1}
+	transformed := MapMapValues(collection, func(key string, value int) string { return fmt.Sprint(key, value) })
+	require.True(test, transformed.Equal(map[string]string{"a": "a0", "b": "b1"}))
+}
+
+func TestMapOf(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, MapOf[int, int]().IsEmpty())
+	require.True(test, MapOf(Entry[int, int]{Key: 0, Value: 1}).Equal(map[int]int{0: 1}))
+}
+
+func TestNewMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewMap[int, int](2)
+	require.True(test, collection.IsEmpty())
+	collection.Put(0, 0)
+	require.True(test, collection.Equal(map[int]int{0: 0}))
+}
+
+func TestReduceMap(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[string, int]{"a": 1, "b": 2, "c": 3}
+	total := ReduceMap(collection, 0, func(accumulator int, key string, value int) (int, bool) {
+		return accumulator + len(key) + value, false
+	})
+	require.Equal(test, 9, total)
+	visited := ReduceMap(collection, 0, func(accumulator int, key string, value int) (int, bool) {
+		return accumulator + 1, true
+	})
+	require.Equal(test, 1, visited)
+}
+
+func TestMap_AddFromChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0}
+	source := Map[int, int]{0: 1, 1: 1}
+	require.NoError(test, collection.AddFromChannel(context.Background(), source.ToChannel(context.Background())))
+	require.True(test, collection.Equal(map[int]int{0: 1, 1: 1}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(test, collection.AddFromChannel(ctx, make(chan Entry[int, int])), context.Canceled)
+}
+
+func TestMap_AsMap(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0}
+	elements := collection.AsMap()
+	elements[1] = 1
+	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1}))
+}
+
+func TestMap_AsReadOnly(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[int, int]
+	view := collection.AsReadOnly()
+	collection.Put(0, 0)
+	require.True(test, view.Equal(map[int]int{0: 0}))
+}
+
+func TestMap_Bind(test *testing.T) {
+	test.Parallel()
+
+	type address struct {
+		City string
+	}
+	type config struct {
+		Name     string
+		Port     int64 `collection:"port"`
+		Ratio    float32
+		Timeout  time.Duration
+		Home     address
+		Work     *address
+		Ignored  string `collection:"-"`
+		Optional []string
+	}
+	collection := Map[string, any]{
+		"name":     "a",
+		"Name":     "b",
+		"port":     8080,
+		"ratio":    0.5,
+		"Timeout":  "1s",
+		"Home":     map[string]any{"city": "c"},
+		"Work":     Map[string, any]{"City": "d"},
+		"Ignored":  "e",
+		"Optional": nil,
+	}
+	var target config
+	coerce := func(value any, kind reflect.Type) (any, error) {
+		if text, ok := value.(string); ok && kind == reflect.TypeOf(time.Duration(0)) {
+			return time.ParseDuration(text)
+		}
+		return value, nil
+	}
+	require.NoError(test, collection.Bind(&target, coerce))
+	require.Equal(test, config{
+		Name:     "b",
+		Port:     8080,
+		Ratio:    0.5,
+		Timeout:  time.Second,
+		Home:     address{City: "c"},
+		Work:     &address{City: "d"},
+		Ignored:  "",
+		Optional: nil,
+	}, target)
+
+	require.ErrorIs(test, collection.Bind(&target, nil), ErrUnsupportedType)
+	require.ErrorIs(test, collection.Bind(target, nil), ErrUnsupportedType)
+	require.ErrorIs(test, Map[int, any]{}.Bind(&target, nil), ErrUnsupportedType)
+	require.ErrorIs(test, Map[string, any]{"Work": map[string]any{"City": 0}}.Bind(&target, nil), ErrUnsupportedType)
+	require.ErrorIs(test, Map[string, any]{"Home": map[string]any{"City": 0}}.Bind(&target, nil), ErrUnsupportedType)
+	require.Error(test, Map[string, any]{"Timeout": "x"}.Bind(&target, coerce))
+
+	type limits struct {
+		Small int8
+		Count uint
+		Ratio float32
+	}
+	var bounded limits
+	require.NoError(test, Map[string, any]{"Small": -128.0, "Count": uint64(3), "Ratio": 1e38}.Bind(&bounded, nil))
+	require.Equal(test, limits{Small: -128, Count: 3, Ratio: 1e38}, bounded)
+	for _, value := range []any{128, uint8(200), 1.5, math.NaN(), math.Inf(1), 1e19} {
+		err := Map[string, any]{"Small": value}.Bind(&bounded, nil)
+		require.ErrorIs(test, err, ErrUnsupportedType)
+		require.ErrorContains(test, err, "field Small")
+	}
+	for _, value := range []any{-1, -1.0, 1e20, math.Inf(-1)} {
+		require.ErrorIs(test, Map[string, any]{"Count": value}.Bind(&bounded, nil), ErrUnsupportedType)
+	}
+	require.ErrorIs(test, Map[string, any]{"Ratio": 1e39}.Bind(&bounded, nil), ErrUnsupportedType)
+	require.Equal(test, limits{Small: -128, Count: 3, Ratio: 1e38}, bounded)
+}
+
 func TestMap_Clear(test *testing.T) {
 	test.Parallel()
 
@@ -39,6 +299,16 @@ func TestMap_Clear(test *testing.T) {
 	require.False(test, collection.Clear())
 }
 
+func TestMap_Clone(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0}
+	clone := collection.Clone()
+	clone.Put(1, 1)
+	require.True(test, collection.Equal(map[int]int{0: 0}))
+	require.True(test, clone.Equal(map[int]int{0: 0, 1: 1}))
+}
+
 func TestMap_ContainsAll(test *testing.T) {
 	test.Parallel()
 
@@ -50,6 +320,15 @@ func TestMap_ContainsAll(test *testing.T) {
 	require.True(test, collection.ContainsAll(map[int]int{0: 0}))
 }
 
+func TestMap_ContainsAnyKey(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1}
+	require.True(test, collection.ContainsAnyKey(2, 1))
+	require.False(test, collection.ContainsAnyKey(2))
+	require.False(test, collection.ContainsAnyKey())
+}
+
 func TestMap_ContainsKey(test *testing.T) {
 	test.Parallel()
 
@@ -68,6 +347,34 @@ func TestMap_ContainsValue(test *testing.T) {
 	require.True(test, collection.ContainsValue(0))
 }
 
+func TestMap_CountValues(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 1, 1: 1, 2: 2}
+	require.Equal(test, 2, collection.CountValues(1))
+	require.Equal(test, 0, collection.CountValues(0))
+}
+
+func TestMap_DecodeJSON(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[int, int]
+	require.Error(test, collection.DecodeJSON(json.NewDecoder(strings.NewReader(`[]`)), nil))
+	require.NoError(test, collection.DecodeJSON(json.NewDecoder(strings.NewReader(`{"0": 0, "1": 1}`)), nil))
+	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1}))
+
+	count := 0
+	decoder := json.NewDecoder(strings.NewReader(`{"2": 2, "3": 3}`))
+	require.NoError(test, collection.DecodeJSON(decoder, func(key, value int) bool {
+		require.Equal(test, 2, key)
+		require.Equal(test, 2, value)
+		count++
+		return false
+	}))
+	require.Equal(test, 1, count)
+	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1, 2: 2}))
+}
+
 func TestMap_Equal(test *testing.T) {
 	test.Parallel()
 
@@ -78,6 +385,37 @@ func TestMap_Equal(test *testing.T) {
 	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1}))
 }
 
+func TestMap_EqualFunc(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, float64]{Key: 0, Value: 0.0}, Entry[int, float64]{Key: 1, Value: 1.0})
+	comparator := func(this float64, that float64) bool {
+		return math.Abs(this-that) < 0.01
+	}
+	require.True(test, collection.EqualFunc(map[int]float64{0: 0.001, 1: 0.999}, comparator))
+	require.False(test, collection.EqualFunc(map[int]float64{0: 0.0, 2: 1.0}, comparator))
+	require.False(test, collection.EqualFunc(map[int]float64{0: 0.0}, comparator))
+}
+
+func TestMap_Filter(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	filtered := collection.Filter(func(key int, value int) bool { return value%2 == 0 })
+	require.True(test, filtered.Equal(map[int]int{0: 0, 2: 2}))
+	require.Equal(test, 3, collection.Size())
+	require.NotNil(test, Map[int, int](nil).Filter(func(int, int) bool { return true }))
+}
+
+func TestMap_FilterInPlace(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	require.Equal(test, 1, collection.FilterInPlace(func(key int, value int) bool { return value%2 == 0 }))
+	require.True(test, collection.Equal(map[int]int{0: 0, 2: 2}))
+	require.Equal(test, 0, collection.FilterInPlace(func(int, int) bool { return true }))
+}
+
 func TestMap_ForEach(test *testing.T) {
 	test.Parallel()
 
@@ -90,6 +428,110 @@ func TestMap_ForEach(test *testing.T) {
 	})
 }
 
+func TestMap_ForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1}
+	count := 0
+	require.NoError(test, collection.ForEachCtx(context.Background(), func(key, value int) bool {
+		count++
+		return true
+	}))
+	require.Equal(test, 2, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := collection.ForEachCtx(ctx, func(key, value int) bool {
+		cancel()
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestMap_ForEachErr(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	count := 0
+	require.NoError(test, collection.ForEachErr(func(key, value int) error {
+		count++
+		return nil
+	}))
+	require.Equal(test, 3, count)
+
+	count = 0
+	err := collection.ForEachErr(func(key, value int) error {
+		count++
+		return io.EOF
+	})
+	require.ErrorIs(test, err, io.EOF)
+	require.Equal(test, 1, count)
+}
+
+func TestMap_ForEachSnapshot(test *testing.T) {
+	test.Parallel()
+
+	var lock sync.Mutex
+	collection := Map[int, int]{0: 0, 1: 1}
+	count := 0
+	collection.ForEachSnapshot(&lock, func(key int, value int) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		count++
+		collection.Put(key+2, value)
+		return true
+	})
+	require.Equal(test, 2, count)
+	require.Equal(test, 4, collection.Size())
+
+	var group sync.WaitGroup
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for index := 0; index < 100; index++ {
+			lock.Lock()
+			collection.Put(index+4, index)
+			lock.Unlock()
+		}
+	}()
+	for index := 0; index < 10; index++ {
+		collection.ForEachSnapshot(&lock, func(key int, value int) bool { return true })
+	}
+	group.Wait()
+	require.Equal(test, 104, collection.Size())
+}
+
+func TestMap_ForEachSorted(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, string]{2: "c", 0: "a", 1: "b"}
+	values := make([]string, 0)
+	collection.ForEachSorted(lessInt, func(key int, value string) bool {
+		values = append(values, value)
+		return key < 1
+	})
+	require.Equal(test, []string{"a", "b"}, values)
+}
+
+func TestMap_Format(test *testing.T) {
+	test.Parallel()
+
+	type element struct{ Name string }
+	collection := Map[int, element]{0: {Name: "a"}}
+	require.Equal(test, "map[0:{a}]", fmt.Sprintf("%v", collection))
+	require.Equal(test, "map[0:{Name:a}]", fmt.Sprintf("%+v", collection))
+	require.Equal(test, "collection.Map[int,int]{0:1}", fmt.Sprintf("%#v", Map[int, int]{0: 1}))
+}
+
+func TestMap_Generate(test *testing.T) {
+	test.Parallel()
+
+	require.NoError(test, quick.Check(func(collection Map[string, int]) bool {
+		return collection.Equal(collection.Clone())
+	}, &quick.Config{MaxCount: 100, MaxCountScale: 0, Rand: nil, Values: nil}))
+	value := Map[string, int]{}.Generate(rand.New(rand.NewSource(0)), 0)
+	require.Empty(test, value.Interface())
+}
+
 func TestMap_Get(test *testing.T) {
 	test.Parallel()
 
@@ -99,6 +541,14 @@ func TestMap_Get(test *testing.T) {
 	require.Equal(test, 1, collection.Get(1))
 }
 
+func TestMap_GetMany(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	require.True(test, collection.GetMany(0, 2, 3).Equal(map[int]int{0: 0, 2: 2}))
+	require.True(test, collection.GetMany().IsEmpty())
+}
+
 func TestMap_GetOrDefault(test *testing.T) {
 	test.Parallel()
 
@@ -108,6 +558,19 @@ func TestMap_GetOrDefault(test *testing.T) {
 	require.Equal(test, 0, collection.GetOrDefault(0, 1))
 }
 
+func TestMap_GetOrPut(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[int, int]
+	current, loaded := collection.GetOrPut(0, 1)
+	require.False(test, loaded)
+	require.Equal(test, 1, current)
+	current, loaded = collection.GetOrPut(0, 2)
+	require.True(test, loaded)
+	require.Equal(test, 1, current)
+	require.True(test, collection.Equal(map[int]int{0: 1}))
+}
+
 func TestMap_IsEmpty(test *testing.T) {
 	test.Parallel()
 
@@ -117,6 +580,15 @@ func TestMap_IsEmpty(test *testing.T) {
 	require.False(test, collection.IsEmpty())
 }
 
+func TestMap_KeySet(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Map[int, int])
+	require.True(test, collection.KeySet().IsEmpty())
+	collection.PutAll(map[int]int{0: 1, 1: 1})
+	require.True(test, collection.KeySet().Equal(0, 1))
+}
+
 func TestMap_Keys(test *testing.T) {
 	test.Parallel()
 
@@ -126,6 +598,30 @@ func TestMap_Keys(test *testing.T) {
 	require.Len(test, collection.Keys(), 1)
 }
 
+func TestMap_LoadFrom(test *testing.T) {
+	test.Parallel()
+
+	var calls atomic.Int64
+	fetch := func(key int) (int, error) {
+		calls.Add(1)
+		if key < 0 {
+			return 0, fmt.Errorf("%w: %v", errNegative, key)
+		}
+		return key * 2, nil
+	}
+	var collection Map[int, int]
+	require.NoError(test, collection.LoadFrom(nil, fetch, 0))
+	require.NotNil(test, collection)
+	collection.Put(1, 1)
+	require.NoError(test, collection.LoadFrom([]int{0, 1, 2, 2, 3}, fetch, 2))
+	require.Equal(test, int64(3), calls.Load())
+	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1, 2: 4, 3: 6}))
+	err := collection.LoadFrom([]int{-1, 4, -2}, fetch, 0)
+	require.ErrorIs(test, err, errNegative)
+	require.Equal(test, "negative: -1\nnegative: -2", err.Error())
+	require.True(test, collection.Equal(map[int]int{0: 0, 1: 1, 2: 4, 3: 6, 4: 8}))
+}
+
 func TestMap_Map(test *testing.T) {
 	test.Parallel()
 
@@ -135,6 +631,17 @@ func TestMap_Map(test *testing.T) {
 	require.Len(test, collection.Map(), 1)
 }
 
+func TestMap_MarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Map[string, int])
+	collection.Put("a", -1)
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+	require.Equal(test, []byte{1, 1, 1, 'a', 1}, data)
+}
+
 func TestMap_MarshalJSON(test *testing.T) {
 	test.Parallel()
 	data, err := json.Marshal(map[int]int{0: 0})
@@ -152,6 +659,104 @@ func TestMap_MarshalJSON(test *testing.T) {
 	}
 }
 
+func TestMap_MarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[string, int]
+	data, err := collection.MarshalJSONWith(JSONOptions{})
+	require.NoError(test, err)
+	require.Equal(test, "{}", string(data))
+	data, err = collection.MarshalJSONWith(JSONOptions{NullEmpty: true})
+	require.NoError(test, err)
+	require.Equal(test, "null", string(data))
+	data, err = Map[string, int]{"b": 1, "a": 0}.MarshalJSONWith(JSONOptions{})
+	require.NoError(test, err)
+	require.Equal(test, `{"a":0,"b":1}`, string(data))
+}
+
+func TestMap_MaxBy(test *testing.T) {
+	test.Parallel()
+
+	_, _, exists := Map[string, int]{}.MaxBy(lessInt)
+	require.False(test, exists)
+	key, value, exists := Map[string, int]{"a": 1, "b": 3, "c": 2}.MaxBy(lessInt)
+	require.True(test, exists)
+	require.Equal(test, "b", key)
+	require.Equal(test, 3, value)
+}
+
+func TestMap_MinBy(test *testing.T) {
+	test.Parallel()
+
+	_, _, exists := Map[string, int]{}.MinBy(lessInt)
+	require.False(test, exists)
+	key, value, exists := Map[string, int]{"a": 1, "b": 3, "c": 2}.MinBy(lessInt)
+	require.True(test, exists)
+	require.Equal(test, "a", key)
+	require.Equal(test, 1, value)
+}
+
+func TestMap_Page(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, string]{3: "d", 0: "a", 4: "e", 1: "b", 2: "c"}
+	page, err := collection.Page(lessInt, 0, 2)
+	require.NoError(test, err)
+	require.Equal(test, List[Entry[int, string]]{{Key: 0, Value: "a"}, {Key: 1, Value: "b"}}, page)
+	page, err = collection.Page(lessInt, 2, 2)
+	require.NoError(test, err)
+	require.Equal(test, List[Entry[int, string]]{{Key: 4, Value: "e"}}, page)
+	page, err = Map[int, string]{}.Page(lessInt, 0, 2)
+	require.NoError(test, err)
+	require.Empty(test, page)
+	_, err = collection.Page(lessInt, 3, 2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.Page(lessInt, -1, 2)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	_, err = collection.Page(lessInt, 0, 0)
+	require.ErrorIs(test, err, ErrInvalidPageSize)
+}
+
+func TestMap_ParallelForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 1, 1: 2, 2: 3}
+	var sum atomic.Int64
+	collection.ParallelForEach(2, func(key, value int) bool {
+		sum.Add(int64(key * value))
+		return true
+	})
+	require.Equal(test, int64(8), sum.Load())
+}
+
+func TestMap_ParallelForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := collection.ParallelForEachCtx(ctx, 2, func(key, value int) bool {
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+	require.NoError(test, collection.ParallelForEachCtx(context.Background(), 2, func(key, value int) bool {
+		return true
+	}))
+}
+
+func TestMap_Pop(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0}
+	previous, existed := collection.Pop(0)
+	require.True(test, existed)
+	require.Equal(test, 0, previous)
+	previous, existed = collection.Pop(0)
+	require.False(test, existed)
+	require.Equal(test, 0, previous)
+	require.True(test, collection.IsEmpty())
+}
+
 func TestMap_Put(test *testing.T) {
 	test.Parallel()
 	collection := make(Map[int, int])
@@ -159,6 +764,10 @@ func TestMap_Put(test *testing.T) {
 	if !collection.Equal(map[int]int{0: 0}) {
 		test.Fatal("method should add element to map")
 	}
+
+	var zero struct{ elements Map[int, int] }
+	zero.elements.Put(0, 0)
+	require.True(test, zero.elements.Equal(map[int]int{0: 0}))
 }
 
 func TestMap_PutAll(test *testing.T) {
@@ -170,6 +779,29 @@ func TestMap_PutAll(test *testing.T) {
 	}
 }
 
+func TestMap_PutPairs(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0}
+	collection.PutPairs(Entry[int, int]{Key: 0, Value: 1}, Entry[int, int]{Key: 1, Value: 1})
+	require.True(test, collection.Equal(map[int]int{0: 1, 1: 1}))
+}
+
+func TestMap_ReleaseToPool(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[string, int]{"a": 0}
+	collection.ReleaseToPool()
+	require.Nil(test, collection)
+	require.True(test, collection.IsEmpty())
+
+	reused := NewMap[string, int](1)
+	require.True(test, reused.IsEmpty())
+	invalid := Map[float64, int]{math.NaN(): 1}
+	invalid.ReleaseToPool()
+	require.True(test, NewMap[float64, int](0).IsEmpty())
+}
+
 func TestMap_Remove(test *testing.T) {
 	test.Parallel()
 	collection := make(Map[int, int])
@@ -182,6 +814,14 @@ func TestMap_Remove(test *testing.T) {
 	}
 }
 
+func TestMap_RemoveMany(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 0, 1: 1, 2: 2}
+	require.Equal(test, 2, collection.RemoveMany(0, 2, 3))
+	require.True(test, collection.Equal(map[int]int{1: 1}))
+}
+
 func TestMap_Size(test *testing.T) {
 	test.Parallel()
 	collection := make(Map[int, int])
@@ -191,6 +831,14 @@ func TestMap_Size(test *testing.T) {
 	}
 }
 
+func TestMap_SortedKeys(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{2: 0, 0: 1, 1: 2}
+	require.Equal(test, []int{0, 1, 2}, collection.SortedKeys(lessInt))
+	require.Empty(test, Map[int, int]{}.SortedKeys(lessInt))
+}
+
 func TestMap_String(test *testing.T) {
 	test.Parallel()
 	collection := make(Map[int, int])
@@ -214,6 +862,32 @@ func TestMap_Swap(test *testing.T) {
 	}
 }
 
+func TestMap_ToChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := Map[int, int]{0: 1, 1: 2}
+	elements := make(map[int]int)
+	for entry := range collection.ToChannel(context.Background()) {
+		elements[entry.Key] = entry.Value
+	}
+	require.True(test, collection.Equal(elements))
+}
+
+func TestMap_UnmarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Map[uint16, [2]byte])
+	collection.PutAll(map[uint16][2]byte{0: {0, 1}, 300: {2, 3}})
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+
+	result := make(Map[uint16, [2]byte])
+	result.Put(1, [2]byte{})
+	require.NoError(test, result.UnmarshalBinary(data))
+	require.True(test, result.Equal(map[uint16][2]byte{0: {0, 1}, 300: {2, 3}}))
+}
+
 func TestMap_UnmarshalJSON(test *testing.T) {
 	test.Parallel()
 	data, err := json.Marshal(map[int]int{0: 0})
@@ -230,6 +904,30 @@ func TestMap_UnmarshalJSON(test *testing.T) {
 	}
 }
 
+func TestMap_UnmarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[string, int]
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`{"a": 0, "a": 1}`), JSONOptions{}))
+	require.True(test, collection.Equal(map[string]int{"a": 1}))
+	options := JSONOptions{DisallowDuplicates: true}
+	require.ErrorIs(test, collection.UnmarshalJSONWith([]byte(`{"b": 0, "b": 1}`), options), ErrDuplicateKey)
+	require.ErrorIs(test, collection.UnmarshalJSONWith([]byte(`{} {}`), options), ErrUnexpectedToken)
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`{"b": "c"}`), options))
+	require.True(test, collection.Equal(map[string]int{"a": 1}))
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`null`), options))
+	require.True(test, collection.IsEmpty())
+}
+
+func TestMap_ValueList(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Map[int, int])
+	require.True(test, collection.ValueList().IsEmpty())
+	collection.PutAll(map[int]int{0: 1, 1: 1})
+	require.True(test, collection.ValueList().Equal(1, 1))
+}
+
 func TestMap_Values(test *testing.T) {
 	test.Parallel()
 	collection := make(Map[int, int])
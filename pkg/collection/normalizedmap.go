@@ -0,0 +1,87 @@
+package collection
+
+// NormalizedMap represents a map with string keys that are normalized by the
+// specified function on every operation, so that keys such as HTTP header
+// names match regardless of case or surrounding whitespace. Keys are stored in
+// normalized form. The zero value is not usable; use NewNormalizedMap instead.
+type NormalizedMap[Value any] struct {
+	elements  Map[string, Value]
+	normalize func(key string) (normalized string)
+}
+
+// NewNormalizedMap returns an empty map that uses the specified function to
+// normalize keys, for example strings.ToLower.
+func NewNormalizedMap[Value any](normalize func(key string) (normalized string)) (collection *NormalizedMap[Value]) {
+	return &NormalizedMap[Value]{elements: make(Map[string, Value]), normalize: normalize}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *NormalizedMap[Value]) Clear() (modified bool) {
+	return collection.elements.Clear()
+}
+
+// ContainsKey returns true if the map contains the normalized form of the
+// specified key.
+func (collection *NormalizedMap[Value]) ContainsKey(key string) (contains bool) {
+	return collection.elements.ContainsKey(collection.normalize(key))
+}
+
+// ForEach performs the specified action for each element of the map until all
+// elements have been processed or the action returns false.
+func (collection *NormalizedMap[Value]) ForEach(action func(key string, value Value) (next bool)) {
+	collection.elements.ForEach(action)
+}
+
+// Get returns the value associated with the normalized form of the specified
+// key, or the zero value if the map does not contain the key.
+func (collection *NormalizedMap[Value]) Get(key string) (current Value) {
+	return collection.elements.Get(collection.normalize(key))
+}
+
+// GetOrDefault returns the value associated with the normalized form of the
+// specified key, or the specified value if the map does not contain the key.
+func (collection *NormalizedMap[Value]) GetOrDefault(key string, value Value) (current Value) {
+	return collection.elements.GetOrDefault(collection.normalize(key), value)
+}
+
+// IsEmpty returns true if the map contains no elements.
+func (collection *NormalizedMap[Value]) IsEmpty() (empty bool) {
+	return collection.elements.IsEmpty()
+}
+
+// Keys returns the normalized keys contained in the map.
+func (collection *NormalizedMap[Value]) Keys() (keys []string) {
+	return collection.elements.Keys()
+}
+
+// Map returns a map containing all of the elements in the map, with normalized
+// keys.
+func (collection *NormalizedMap[Value]) Map() (elements map[string]Value) {
+	return collection.elements.Map()
+}
+
+// Put associates the specified value with the normalized form of the specified
+// key in the map.
+func (collection *NormalizedMap[Value]) Put(key string, value Value) {
+	collection.elements.Put(collection.normalize(key), value)
+}
+
+// PutAll associates each of the specified values with the normalized form of
+// its key in the map. If more than one key has the same normalized form, an
+// arbitrary one of the values is retained.
+func (collection *NormalizedMap[Value]) PutAll(elements map[string]Value) {
+	for key, value := range elements {
+		collection.Put(key, value)
+	}
+}
+
+// Remove removes the normalized form of the specified key from the map,
+// returning the previous value, if any.
+func (collection *NormalizedMap[Value]) Remove(key string) (previous Value) {
+	return collection.elements.Remove(collection.normalize(key))
+}
+
+// Size returns the number of elements in the map.
+func (collection *NormalizedMap[Value]) Size() (size int) {
+	return collection.elements.Size()
+}
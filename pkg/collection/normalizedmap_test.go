@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNormalizedMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[string](http.CanonicalHeaderKey)
+	require.True(test, collection.IsEmpty())
+	collection.Put("content-type", "text/plain")
+	require.Equal(test, "text/plain", collection.Get("CONTENT-TYPE"))
+	require.Equal(test, []string{"Content-Type"}, collection.Keys())
+}
+
+func TestNormalizedMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 0)
+	require.True(test, collection.Clear())
+	require.True(test, collection.IsEmpty())
+	require.False(test, collection.Clear())
+}
+
+func TestNormalizedMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.TrimSpace)
+	collection.Put(" a ", 0)
+	require.True(test, collection.ContainsKey("a"))
+	require.True(test, collection.ContainsKey("a\n"))
+	require.False(test, collection.ContainsKey("b"))
+}
+
+func TestNormalizedMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.PutAll(map[string]int{"A": 0, "B": 1})
+	keys := make([]string, 0)
+	collection.ForEach(func(key string, value int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Strings(keys)
+	require.Equal(test, []string{"a", "b"}, keys)
+}
+
+func TestNormalizedMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 1)
+	require.Equal(test, 1, collection.Get("a"))
+	require.Equal(test, 0, collection.Get("b"))
+}
+
+func TestNormalizedMap_GetOrDefault(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 1)
+	require.Equal(test, 1, collection.GetOrDefault("a", 2))
+	require.Equal(test, 2, collection.GetOrDefault("B", 2))
+}
+
+func TestNormalizedMap_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	require.True(test, collection.IsEmpty())
+	collection.Put("A", 0)
+	require.False(test, collection.IsEmpty())
+}
+
+func TestNormalizedMap_Keys(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 0)
+	require.Equal(test, []string{"a"}, collection.Keys())
+}
+
+func TestNormalizedMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 0)
+	require.Equal(test, map[string]int{"a": 0}, collection.Map())
+}
+
+func TestNormalizedMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 0)
+	collection.Put("a", 1)
+	require.Equal(test, map[string]int{"a": 1}, collection.Map())
+}
+
+func TestNormalizedMap_PutAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.PutAll(map[string]int{"A": 0, "B": 1})
+	require.Equal(test, map[string]int{"a": 0, "b": 1}, collection.Map())
+}
+
+func TestNormalizedMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.Put("A", 1)
+	require.Equal(test, 1, collection.Remove("a"))
+	require.Equal(test, 0, collection.Remove("A"))
+	require.True(test, collection.IsEmpty())
+}
+
+func TestNormalizedMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNormalizedMap[int](strings.ToLower)
+	collection.PutAll(map[string]int{"A": 0, "a": 1, "b": 2})
+	require.Equal(test, 2, collection.Size())
+}
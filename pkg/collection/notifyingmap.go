@@ -0,0 +1,201 @@
+package collection
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MapEventKind indicates the change to a map that produced an event.
+type MapEventKind int
+
+const (
+	// MapEntryAdded indicates that a value was associated with a new key.
+	MapEntryAdded MapEventKind = iota
+	// MapEntryUpdated indicates that the value of an existing key was replaced.
+	MapEntryUpdated
+	// MapEntryRemoved indicates that a key was explicitly removed.
+	MapEntryRemoved
+	// MapEntryExpired indicates that the time to live of a key elapsed.
+	MapEntryExpired
+	// MapEntryEvicted indicates that a key was evicted to stay within capacity.
+	MapEntryEvicted
+)
+
+// String returns a string representation of the kind.
+func (kind MapEventKind) String() (name string) {
+	switch kind {
+	case MapEntryAdded:
+		return "added"
+	case MapEntryUpdated:
+		return "updated"
+	case MapEntryRemoved:
+		return "removed"
+	case MapEntryExpired:
+		return "expired"
+	case MapEntryEvicted:
+		return "evicted"
+	default:
+		return "unknown"
+	}
+}
+
+// MapEvent represents a change to a notifying map. Value is the value after an
+// addition or update, or the removed value otherwise. Previous is the replaced
+// value of an update, and the zero value otherwise.
+type MapEvent[Key comparable, Value any] struct {
+	Kind     MapEventKind
+	Key      Key
+	Value    Value
+	Previous Value
+}
+
+// NotifyingMap represents a cache that publishes an event for each change to
+// its entries, including expirations and evictions, to every subscriber. Events
+// are delivered without blocking, so an event is dropped for any subscriber
+// whose channel is full; use Dropped to detect this. The map is safe for
+// concurrent use. The zero value is not usable; use NewNotifyingMap instead.
+type NotifyingMap[Key comparable, Value any] struct {
+	mutex       sync.Mutex
+	entries     *Cache[Key, Value]
+	pending     []MapEvent[Key, Value]
+	subscribers map[chan MapEvent[Key, Value]]struct{}
+	dropped     atomic.Uint64
+}
+
+// NewNotifyingMap returns an empty map with the specified capacity and default
+// time to live, measured by the specified clock, with the same meaning as for
+// NewCache.
+func NewNotifyingMap[Key comparable, Value any](
+	capacity int, ttl time.Duration, clock func() (now time.Time),
+) (collection *NotifyingMap[Key, Value]) {
+	collection = &NotifyingMap[Key, Value]{
+		mutex:       sync.Mutex{},
+		entries:     nil,
+		pending:     nil,
+		subscribers: make(map[chan MapEvent[Key, Value]]struct{}),
+		dropped:     atomic.Uint64{},
+	}
+	collection.entries = NewCache(capacity, ttl, clock, collection.evicted)
+	return collection
+}
+
+// Cleanup removes all expired entries from the map, returning the number of
+// entries removed.
+func (collection *NotifyingMap[Key, Value]) Cleanup() (removed int) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	defer collection.publish()
+	return collection.entries.Cleanup()
+}
+
+// Dropped returns the number of events that could not be delivered because a
+// subscriber's channel was full.
+func (collection *NotifyingMap[Key, Value]) Dropped() (dropped uint64) {
+	return collection.dropped.Load()
+}
+
+// Get returns the value associated with the specified key and true if the map
+// contains an unexpired entry for the key. Otherwise, the zero value and false
+// are returned.
+func (collection *NotifyingMap[Key, Value]) Get(key Key) (current Value, exists bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	defer collection.publish()
+	return collection.entries.GetIfPresent(key)
+}
+
+// Put associates the specified value with the specified key in the map, using
+// the default time to live.
+func (collection *NotifyingMap[Key, Value]) Put(key Key, value Value) {
+	collection.PutWithTTL(key, value, collection.entries.ttl)
+}
+
+// PutWithTTL associates the specified value with the specified key in the map,
+// using the specified time to live. A time to live that is not positive never
+// expires.
+func (collection *NotifyingMap[Key, Value]) PutWithTTL(key Key, value Value, ttl time.Duration) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	defer collection.publish()
+	var empty Value
+	event := MapEvent[Key, Value]{Kind: MapEntryAdded, Key: key, Value: value, Previous: empty}
+	if previous, exists := collection.entries.GetIfPresent(key); exists {
+		event.Kind = MapEntryUpdated
+		event.Previous = previous
+	}
+	collection.pending = append(collection.pending, event)
+	collection.entries.PutWithTTL(key, value, ttl)
+}
+
+// Remove removes the specified key from the map, returning true if the map
+// contained the key.
+func (collection *NotifyingMap[Key, Value]) Remove(key Key) (modified bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	defer collection.publish()
+	return collection.entries.Invalidate(key)
+}
+
+// Size returns the number of entries in the map, including expired entries
+// that have not yet been removed.
+func (collection *NotifyingMap[Key, Value]) Size() (size int) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return collection.entries.Size()
+}
+
+// Subscribe returns a channel with the specified buffer size that receives an
+// event for each subsequent change to the map, and a function that
+// unsubscribes and closes the channel. The function may be called more than
+// once.
+func (collection *NotifyingMap[Key, Value]) Subscribe(
+	buffer int,
+) (events <-chan MapEvent[Key, Value], cancel func()) {
+	channel := make(chan MapEvent[Key, Value], buffer)
+	collection.mutex.Lock()
+	collection.subscribers[channel] = struct{}{}
+	collection.mutex.Unlock()
+	var once sync.Once
+	return channel, func() {
+		once.Do(func() {
+			collection.mutex.Lock()
+			delete(collection.subscribers, channel)
+			collection.mutex.Unlock()
+			close(channel)
+		})
+	}
+}
+
+// evicted records an event for the specified entry removed from the cache.
+func (collection *NotifyingMap[Key, Value]) evicted(key Key, value Value, reason EvictionReason) {
+	var empty Value
+	event := MapEvent[Key, Value]{Kind: MapEntryRemoved, Key: key, Value: value, Previous: empty}
+	switch reason {
+	case EvictionCapacity:
+		event.Kind = MapEntryEvicted
+	case EvictionExpired:
+		event.Kind = MapEntryExpired
+	case EvictionInvalidated:
+	case EvictionReplaced:
+		return
+	}
+	collection.pending = append(collection.pending, event)
+}
+
+// publish delivers the recorded events to every subscriber without blocking.
+// The caller must hold the lock.
+func (collection *NotifyingMap[Key, Value]) publish() {
+	var empty MapEvent[Key, Value]
+	for index, event := range collection.pending {
+		for channel := range collection.subscribers {
+			select {
+			case channel <- event:
+			default:
+				collection.dropped.Add(1)
+			}
+		}
+		collection.pending[index] = empty
+	}
+	collection.pending = collection.pending[:0]
+}
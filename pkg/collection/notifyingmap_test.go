@@ -0,0 +1,164 @@
+package collection
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// drainEvents returns a description of each event buffered in the specified
+// channel.
+func drainEvents(events <-chan MapEvent[string, int]) (descriptions []string) {
+	descriptions = make([]string, 0)
+	for {
+		select {
+		case event := <-events:
+			descriptions = append(descriptions, fmt.Sprintf("%v %s=%d", event.Kind, event.Key, event.Value))
+		default:
+			return descriptions
+		}
+	}
+}
+
+func TestMapEventKind_String(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, "added", MapEntryAdded.String())
+	require.Equal(test, "updated", MapEntryUpdated.String())
+	require.Equal(test, "removed", MapEntryRemoved.String())
+	require.Equal(test, "expired", MapEntryExpired.String())
+	require.Equal(test, "evicted", MapEntryEvicted.String())
+	require.Equal(test, "unknown", MapEventKind(-1).String())
+}
+
+func TestNewNotifyingMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNotifyingMap[string, int](0, 0, nil)
+	collection.Put("a", 0)
+	value, exists := collection.Get("a")
+	require.True(test, exists)
+	require.Equal(test, 0, value)
+}
+
+func TestNotifyingMap_Cleanup(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewNotifyingMap[string, int](0, time.Minute, fakeClock(&now))
+	collection.Put("a", 0)
+	collection.PutWithTTL("b", 1, 0)
+	events, cancel := collection.Subscribe(8)
+	defer cancel()
+	now = now.Add(time.Minute)
+	require.Equal(test, 1, collection.Cleanup())
+	require.Equal(test, []string{"expired a=0"}, drainEvents(events))
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestNotifyingMap_Dropped(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNotifyingMap[string, int](0, 0, nil)
+	events, cancel := collection.Subscribe(1)
+	defer cancel()
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	collection.Put("c", 2)
+	require.Equal(test, uint64(2), collection.Dropped())
+	require.Equal(test, []string{"added a=0"}, drainEvents(events))
+}
+
+func TestNotifyingMap_Get(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewNotifyingMap[string, int](0, time.Minute, fakeClock(&now))
+	events, cancel := collection.Subscribe(8)
+	defer cancel()
+	collection.Put("a", 0)
+	now = now.Add(time.Minute)
+	_, exists := collection.Get("a")
+	require.False(test, exists)
+	_, exists = collection.Get("b")
+	require.False(test, exists)
+	require.Equal(test, []string{"added a=0", "expired a=0"}, drainEvents(events))
+}
+
+func TestNotifyingMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNotifyingMap[string, int](2, 0, nil)
+	events, cancel := collection.Subscribe(8)
+	defer cancel()
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	collection.Put("a", 2)
+	collection.Put("c", 3)
+	require.Equal(test, []string{"added a=0", "added b=1", "updated a=2", "added c=3", "evicted b=1"},
+		drainEvents(events))
+}
+
+func TestNotifyingMap_PutWithTTL(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewNotifyingMap[string, int](0, 0, fakeClock(&now))
+	events, cancel := collection.Subscribe(8)
+	defer cancel()
+	collection.PutWithTTL("a", 0, time.Minute)
+	collection.PutWithTTL("a", 1, time.Minute)
+	event := <-events
+	require.Equal(test, MapEntryAdded, event.Kind)
+	event = <-events
+	require.Equal(test, MapEntryUpdated, event.Kind)
+	require.Equal(test, 0, event.Previous)
+	require.Equal(test, 1, event.Value)
+	now = now.Add(time.Minute)
+	collection.PutWithTTL("a", 2, time.Minute)
+	require.Equal(test, []string{"expired a=1", "added a=2"}, drainEvents(events))
+}
+
+func TestNotifyingMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNotifyingMap[string, int](0, 0, nil)
+	collection.Put("a", 0)
+	events, cancel := collection.Subscribe(8)
+	defer cancel()
+	require.True(test, collection.Remove("a"))
+	require.False(test, collection.Remove("a"))
+	require.Equal(test, []string{"removed a=0"}, drainEvents(events))
+}
+
+func TestNotifyingMap_Subscribe(test *testing.T) {
+	test.Parallel()
+
+	collection := NewNotifyingMap[string, int](0, 0, nil)
+	first, cancelFirst := collection.Subscribe(0)
+	second, cancelSecond := collection.Subscribe(8)
+	var group sync.WaitGroup
+	received := make([]string, 0)
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for event := range first {
+			received = append(received, fmt.Sprintf("%v %s=%d", event.Kind, event.Key, event.Value))
+		}
+	}()
+	collection.Put("a", 0)
+	cancelSecond()
+	cancelSecond()
+	collection.Put("b", 1)
+	cancelFirst()
+	group.Wait()
+	require.Equal(test, uint64(len(received)), 2-collection.Dropped())
+	event, open := <-second
+	require.True(test, open)
+	require.Equal(test, "a", event.Key)
+	_, open = <-second
+	require.False(test, open)
+}
@@ -0,0 +1,148 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OrderedMap represents a map that remembers the order in which its keys were
+// first added, and marshals to a JSON object with its keys in that order.
+// UnmarshalJSON records the key order of the JSON object, and MarshalJSON
+// reproduces the original data byte for byte until the map is next modified,
+// so documents can pass through unchanged for signing and verification. Note
+// that json.Marshal compacts the data, so call MarshalJSON directly when the
+// original whitespace must be kept. Use json.RawMessage values to preserve the
+// order of nested objects after a modification. The map is not safe for
+// concurrent use. The zero value is an empty map.
+type OrderedMap[Key comparable, Value any] struct {
+	keys     []Key
+	elements Map[Key, Value]
+	original []byte
+}
+
+// NewOrderedMap returns an empty ordered map.
+func NewOrderedMap[Key comparable, Value any]() (collection *OrderedMap[Key, Value]) {
+	return &OrderedMap[Key, Value]{keys: nil, elements: make(Map[Key, Value]), original: nil}
+}
+
+// Clear removes all of the elements from the map.
+func (collection *OrderedMap[Key, Value]) Clear() (modified bool) {
+	modified = len(collection.keys) > 0
+	collection.keys = nil
+	collection.elements = make(Map[Key, Value])
+	collection.original = nil
+	return modified
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (collection *OrderedMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	return collection.elements.ContainsKey(key)
+}
+
+// ForEach performs the specified action for each element of the map, in key
+// order, until all elements have been processed or the action returns false.
+func (collection *OrderedMap[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) {
+	for _, key := range collection.keys {
+		if !action(key, collection.elements[key]) {
+			return
+		}
+	}
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the key.
+func (collection *OrderedMap[Key, Value]) Get(key Key) (current Value) {
+	return collection.elements.Get(key)
+}
+
+// IsEmpty returns true if the map contains no elements.
+func (collection *OrderedMap[Key, Value]) IsEmpty() (empty bool) {
+	return len(collection.keys) == 0
+}
+
+// Keys returns the keys contained in the map, in order.
+func (collection *OrderedMap[Key, Value]) Keys() (keys []Key) {
+	return append(make([]Key, 0, len(collection.keys)), collection.keys...)
+}
+
+// MarshalJSON returns a byte representation of the map as a JSON object with
+// its keys in order. If the map has not been modified since it was
+// unmarshaled, the original data is returned unchanged.
+func (collection OrderedMap[Key, Value]) MarshalJSON() (elements []byte, err error) {
+	if collection.original != nil {
+		return append(make([]byte, 0, len(collection.original)), collection.original...), nil
+	}
+	buffer := bytes.NewBufferString("{")
+	for index, key := range collection.keys {
+		var element []byte
+		element, err = json.Marshal(map[Key]Value{key: collection.elements[key]})
+		if err != nil {
+			return nil, err
+		}
+		if index > 0 {
+			_ = buffer.WriteByte(',')
+		}
+		_, _ = buffer.Write(element[1 : len(element)-1])
+	}
+	_ = buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// Put associates the specified value with the specified key in the map. A new
+// key is added after all existing keys, and an existing key keeps its
+// position.
+func (collection *OrderedMap[Key, Value]) Put(key Key, value Value) {
+	collection.elements.initialize()
+	if !collection.elements.ContainsKey(key) {
+		collection.keys = append(collection.keys, key)
+	}
+	collection.elements[key] = value
+	collection.original = nil
+}
+
+// Remove removes the specified key from the map, returning true if the map
+// contained the key.
+func (collection *OrderedMap[Key, Value]) Remove(key Key) (modified bool) {
+	if !collection.elements.ContainsKey(key) {
+		return false
+	}
+	delete(collection.elements, key)
+	for index, current := range collection.keys {
+		if current == key {
+			collection.keys = append(collection.keys[:index], collection.keys[index+1:]...)
+			break
+		}
+	}
+	collection.original = nil
+	return true
+}
+
+// Size returns the number of elements in the map.
+func (collection *OrderedMap[Key, Value]) Size() (size int) {
+	return len(collection.keys)
+}
+
+// UnmarshalJSON replaces all of the map's elements with the elements of the
+// specified JSON object, recording the order of its keys and the original
+// data. A duplicate key keeps the position of its first occurrence and the
+// value of its last. JSON null is treated as an empty map.
+func (collection *OrderedMap[Key, Value]) UnmarshalJSON(elements []byte) (err error) {
+	decoder := json.NewDecoder(bytes.NewReader(elements))
+	buffer := make(Map[Key, Value])
+	keys := make([]Key, 0)
+	err = buffer.DecodeJSON(decoder, func(key Key, _ Value) bool {
+		if len(buffer) > len(keys) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	} else if err = finishJSON(decoder); err != nil {
+		return err
+	}
+	collection.keys = keys
+	collection.elements = buffer
+	collection.original = append(make([]byte, 0, len(elements)), elements...)
+	return nil
+}
@@ -0,0 +1,151 @@
+package collection
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderedMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	require.True(test, collection.IsEmpty())
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestOrderedMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	var collection OrderedMap[string, int]
+	require.False(test, collection.Clear())
+	collection.Put("a", 0)
+	require.True(test, collection.Clear())
+	require.Equal(test, 0, collection.Size())
+	require.False(test, collection.ContainsKey("a"))
+}
+
+func TestOrderedMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("a", 0)
+	require.True(test, collection.ContainsKey("a"))
+	require.False(test, collection.ContainsKey("b"))
+}
+
+func TestOrderedMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("c", 0)
+	collection.Put("a", 1)
+	collection.Put("b", 2)
+	keys := make([]string, 0)
+	collection.ForEach(func(key string, value int) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	require.Equal(test, []string{"c", "a"}, keys)
+}
+
+func TestOrderedMap_Get(test *testing.T) {
+	test.Parallel()
+
+	var collection OrderedMap[string, int]
+	require.Equal(test, 0, collection.Get("a"))
+	collection.Put("a", 1)
+	require.Equal(test, 1, collection.Get("a"))
+}
+
+func TestOrderedMap_Keys(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("b", 0)
+	collection.Put("a", 1)
+	keys := collection.Keys()
+	require.Equal(test, []string{"b", "a"}, keys)
+	keys[0] = "z"
+	require.Equal(test, []string{"b", "a"}, collection.Keys())
+}
+
+func TestOrderedMap_MarshalJSON(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[int, string]()
+	data, err := json.Marshal(collection)
+	require.NoError(test, err)
+	require.Equal(test, `{}`, string(data))
+	collection.Put(3, "<c>")
+	collection.Put(1, "a")
+	collection.Put(2, "b")
+	data, err = json.Marshal(collection)
+	require.NoError(test, err)
+	require.Equal(test, `{"3":"\u003cc\u003e","1":"a","2":"b"}`, string(data))
+	invalid := NewOrderedMap[string, func()]()
+	invalid.Put("a", func() {})
+	_, err = json.Marshal(invalid)
+	require.Error(test, err)
+}
+
+func TestOrderedMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("b", 0)
+	collection.Put("a", 1)
+	collection.Put("b", 2)
+	require.Equal(test, []string{"b", "a"}, collection.Keys())
+	require.Equal(test, 2, collection.Get("b"))
+}
+
+func TestOrderedMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("a", 0)
+	collection.Put("b", 1)
+	collection.Put("c", 2)
+	require.True(test, collection.Remove("b"))
+	require.False(test, collection.Remove("b"))
+	require.Equal(test, []string{"a", "c"}, collection.Keys())
+}
+
+func TestOrderedMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewOrderedMap[string, int]()
+	collection.Put("a", 0)
+	collection.Put("a", 1)
+	require.Equal(test, 1, collection.Size())
+}
+
+func TestOrderedMap_UnmarshalJSON(test *testing.T) {
+	test.Parallel()
+
+	original := "{ \"z\": 1.50, \"a\": {\"y\": 1, \"x\": 2},\n  \"m\": \"\\u00e9\" }"
+	var collection OrderedMap[string, json.RawMessage]
+	require.NoError(test, json.Unmarshal([]byte(original), &collection))
+	require.Equal(test, []string{"z", "a", "m"}, collection.Keys())
+	data, err := collection.MarshalJSON()
+	require.NoError(test, err)
+	require.Equal(test, original, string(data))
+	data, err = json.Marshal(collection)
+	require.NoError(test, err)
+	require.Equal(test, `{"z":1.50,"a":{"y":1,"x":2},"m":"\u00e9"}`, string(data))
+	collection.Put("b", json.RawMessage(`true`))
+	data, err = json.Marshal(collection)
+	require.NoError(test, err)
+	require.Equal(test, `{"z":1.50,"a":{"y":1,"x":2},"m":"\u00e9","b":true}`, string(data))
+	var duplicates OrderedMap[string, int]
+	require.NoError(test, json.Unmarshal([]byte(`{"a":1,"b":2,"a":3}`), &duplicates))
+	require.Equal(test, []string{"a", "b"}, duplicates.Keys())
+	require.Equal(test, 3, duplicates.Get("a"))
+	require.NoError(test, json.Unmarshal([]byte(`null`), &duplicates))
+	require.True(test, duplicates.IsEmpty())
+	require.Error(test, json.Unmarshal([]byte(`[1]`), &duplicates))
+	require.Error(test, duplicates.UnmarshalJSON([]byte(`{} {}`)))
+	require.Error(test, json.Unmarshal([]byte(`{"a":"b"}`), &duplicates))
+}
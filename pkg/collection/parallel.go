@@ -0,0 +1,130 @@
+package collection
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// MapReduce applies the specified mapper to each partition of the specified
+// size over the values of the list concurrently, using GOMAXPROCS goroutines,
+// and then combines the mapped results with the specified reducer in partition
+// order. If the list is empty or the size is less than one, the zero value is
+// returned. If the mapper panics, the panic is propagated to the caller.
+func MapReduce[Value any, Result any](
+	collection List[Value], size int,
+	mapper func(values []Value) (result Result), reducer func(accumulator Result, result Result) (combined Result),
+) (result Result) {
+	if len(collection) == 0 || size < 1 {
+		return result
+	}
+	results := make([]Result, (len(collection)+size-1)/size)
+	_ = parallelForEach(context.Background(), 0, func(yield func(partition Entry[int, []Value]) bool) {
+		index := 0
+		collection.Partitions(size, func(values []Value) bool {
+			index++
+			return yield(Entry[int, []Value]{Key: index - 1, Value: values})
+		})
+	}, func(partition Entry[int, []Value]) bool {
+		results[partition.Key] = mapper(partition.Value)
+		return true
+	})
+	result = results[0]
+	for _, current := range results[1:] {
+		result = reducer(result, current)
+	}
+	return result
+}
+
+// parallelForEach performs the specified action for each element produced by
+// the specified sequence, using the specified number of goroutines, until all
+// elements have been processed, the action returns false, or the context is
+// done, in which case the context error is returned. If the number of
+// goroutines is less than one, GOMAXPROCS goroutines are used. If the action
+// panics, no further elements are processed and the panic is propagated to
+// the caller once all goroutines have finished.
+func parallelForEach[Element any](
+	ctx context.Context, workers int,
+	sequence func(yield func(element Element) (next bool)), action func(element Element) (next bool),
+) (err error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	var stopped, canceled atomic.Bool
+	var failure any
+	var once sync.Once
+	var group sync.WaitGroup
+	channel := make(chan Element)
+	for worker := 0; worker < workers; worker++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for element := range channel {
+				if stopped.Load() {
+					continue
+				} else if ctx.Err() != nil {
+					canceled.Store(true)
+					continue
+				}
+				parallelAction(element, action, &stopped, func(recovered any) {
+					once.Do(func() { failure = recovered })
+				})
+			}
+		}()
+	}
+	sequence(func(element Element) bool {
+		if stopped.Load() {
+			return false
+		}
+		select {
+		case channel <- element:
+			return true
+		case <-ctx.Done():
+			canceled.Store(true)
+			return false
+		}
+	})
+	close(channel)
+	group.Wait()
+	if failure != nil {
+		panic(failure)
+	} else if canceled.Load() {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// parallelAction performs the specified action for the specified element,
+// setting the stopped flag if the action returns false or panics.
+func parallelAction[Element any](
+	element Element, action func(element Element) (next bool), stopped *atomic.Bool, recovered func(failure any),
+) {
+	defer func() {
+		if failure := recover(); failure != nil {
+			stopped.Store(true)
+			recovered(failure)
+		}
+	}()
+	if !action(element) {
+		stopped.Store(true)
+	}
+}
+
+// sendAll returns a channel that receives each of the specified elements, in
+// order. The channel is closed once all elements have been sent or the context
+// is done.
+func sendAll[Element any](ctx context.Context, elements []Element) (channel <-chan Element) {
+	output := make(chan Element)
+	go func() {
+		defer close(output)
+		for _, element := range elements {
+			select {
+			case output <- element:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return output
+}
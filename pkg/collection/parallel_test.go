@@ -0,0 +1,34 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapReduce(test *testing.T) {
+	test.Parallel()
+
+	collection := make(List[int], 0)
+	for value := 1; value <= 1000; value++ {
+		require.True(test, collection.Add(value))
+	}
+	sum := func(values []int) int {
+		result := 0
+		for _, value := range values {
+			result += value
+		}
+		return result
+	}
+	add := func(accumulator, result int) int { return accumulator + result }
+	require.Equal(test, 500500, MapReduce(collection, 7, sum, add))
+	require.Equal(test, 0, MapReduce(collection, 0, sum, add))
+	require.Equal(test, 0, MapReduce(List[int]{}, 7, sum, add))
+
+	join := MapReduce(ListOf("a", "b", "c"), 2, func(values []string) string {
+		return ListFromSlice(values).Join("")
+	}, func(accumulator, result string) string {
+		return accumulator + "," + result
+	})
+	require.Equal(test, "ab,c", join)
+}
@@ -0,0 +1,122 @@
+package collection
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// pools contains a pool of reusable buffers for each buffer type.
+	pools sync.Map
+	// released is set once a collection has been released to a pool, so that
+	// constructors skip the pool lookup until pooling is in use.
+	released atomic.Bool
+)
+
+// pooledMap represents a map in a pool and the number of elements it held,
+// which it can hold again without growing.
+type pooledMap[Key comparable, Value any] struct {
+	elements map[Key]Value
+	capacity int
+}
+
+// bufferPool returns the pool of reusable buffers of the specified type.
+func bufferPool[Buffer any]() (pool *sync.Pool) {
+	key := reflect.TypeOf((*Buffer)(nil)).Elem()
+	current, exists := pools.Load(key)
+	if !exists {
+		current, _ = pools.LoadOrStore(key, new(sync.Pool))
+	}
+	pool, _ = current.(*sync.Pool)
+	return pool
+}
+
+// getSlice returns a pointer to an empty slice with at least the specified
+// capacity, reusing a pooled buffer if one is available. Returning the pointer
+// to putSlice reuses the slice header as well, so a round trip through the
+// pool does not allocate.
+func getSlice[Value any](capacity int) (buffer *[]Value) {
+	pool := bufferPool[[]Value]()
+	if pooled, valid := pool.Get().(*[]Value); valid {
+		if cap(*pooled) >= capacity {
+			*pooled = (*pooled)[:0]
+			return pooled
+		}
+		pool.Put(pooled)
+	}
+	buffer = new([]Value)
+	*buffer = make([]Value, 0, capacity)
+	return buffer
+}
+
+// putSlice clears the specified slice and returns it to the pool. The slice
+// must not be used after it has been returned.
+func putSlice[Value any](buffer *[]Value) {
+	var empty Value
+	*buffer = (*buffer)[:cap(*buffer)]
+	for index := range *buffer {
+		(*buffer)[index] = empty
+	}
+	*buffer = (*buffer)[:0]
+	bufferPool[[]Value]().Put(buffer)
+}
+
+// getMap returns an empty map that can hold at least the specified number of
+// elements without growing, reusing a pooled buffer if one is available.
+func getMap[Key comparable, Value any](capacity int) (buffer map[Key]Value) {
+	pool := bufferPool[pooledMap[Key, Value]]()
+	if pooled, valid := pool.Get().(*pooledMap[Key, Value]); valid {
+		if pooled.capacity >= capacity {
+			return pooled.elements
+		}
+		pool.Put(pooled)
+	}
+	return make(map[Key]Value, capacity)
+}
+
+// newMap returns an empty map with the specified initial capacity, reusing a
+// pooled buffer once a collection has been released to a pool.
+func newMap[Key comparable, Value any](capacity int) (buffer map[Key]Value) {
+	if !released.Load() {
+		return make(map[Key]Value, capacity)
+	}
+	return getMap[Key, Value](capacity)
+}
+
+// newSlice returns an empty slice with at least the specified capacity, reusing
+// a pooled buffer, with all of its capacity, once a collection has been
+// released to a pool.
+func newSlice[Value any](capacity int) (buffer []Value) {
+	if !released.Load() {
+		return make([]Value, 0, capacity)
+	}
+	return *getSlice[Value](capacity)
+}
+
+// putMap clears the specified map and returns it to the pool. The map must not
+// be used after it has been returned. A map that cannot be cleared, because it
+// contains NaN keys that cannot be deleted, is not pooled.
+func putMap[Key comparable, Value any](buffer map[Key]Value) {
+	capacity := len(buffer)
+	for key := range buffer {
+		delete(buffer, key)
+	}
+	if len(buffer) > 0 {
+		return
+	}
+	bufferPool[pooledMap[Key, Value]]().Put(&pooledMap[Key, Value]{elements: buffer, capacity: capacity})
+}
+
+// releaseMap returns the specified map to the pool on behalf of ReleaseToPool.
+func releaseMap[Key comparable, Value any](buffer map[Key]Value) {
+	released.Store(true)
+	putMap(buffer)
+}
+
+// releaseSlice returns the specified slice to the pool on behalf of
+// ReleaseToPool.
+func releaseSlice[Value any](buffer []Value) {
+	released.Store(true)
+	putSlice(&buffer)
+}
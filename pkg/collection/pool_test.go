@@ -0,0 +1,57 @@
+package collection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMap(test *testing.T) {
+	test.Parallel()
+
+	type key struct{ name string }
+	putMap(map[key]int{{"a"}: 0, {"b"}: 1})
+	small := getMap[key, int](2)
+	require.Empty(test, small)
+	putMap(small)
+	large := getMap[key, int](3)
+	require.Empty(test, large)
+	require.Empty(test, getMap[key, int](0))
+}
+
+func TestNewSlice(test *testing.T) {
+	test.Parallel()
+
+	type value struct{ name string }
+	releaseSlice(make([]value, 4, 8))
+	buffer := newSlice[value](2)
+	require.Empty(test, buffer)
+	require.GreaterOrEqual(test, cap(buffer), 2)
+}
+
+func TestPutSlice(test *testing.T) { //nolint:paralleltest // AllocsPerRun cannot run in parallel.
+	type value struct{ name *string }
+	name := "a"
+	buffer := getSlice[value](4)
+	*buffer = append(*buffer, value{name: &name})
+	putSlice(buffer)
+	require.Empty(test, *buffer)
+	require.Nil(test, (*buffer)[:1][0].name)
+	allocations := testing.AllocsPerRun(100, func() {
+		buffer := getSlice[value](4)
+		*buffer = append(*buffer, value{name: &name})
+		putSlice(buffer)
+	})
+	require.Less(test, allocations, 1.0)
+}
+
+func TestPutMap(test *testing.T) {
+	test.Parallel()
+
+	type key float64
+	putMap(map[key]int{key(math.NaN()): 1})
+	require.Empty(test, getMap[key, int](0))
+	putMap(map[key]int{1: 1})
+	require.Empty(test, getMap[key, int](0))
+}
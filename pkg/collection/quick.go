@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"unicode/utf8"
+)
+
+// generateValues performs the specified action for each of up to the specified
+// number of random values. No values are generated if the value type contains
+// a kind that cannot be generated, such as a function, channel, or interface.
+func generateValues[Value any](random *rand.Rand, size int, action func(value Value)) {
+	if size <= 0 {
+		return
+	}
+	kind := reflect.TypeOf((*Value)(nil)).Elem()
+	for index := random.Intn(size + 1); index > 0; index-- {
+		value, ok := randomValue(kind, random, size)
+		if !ok {
+			return
+		}
+		current, _ := value.Interface().(Value)
+		action(current)
+	}
+}
+
+// randomValue returns a random value of the specified type, with up to the
+// specified number of values in each nested string, slice, and map, or false
+// if the type cannot be generated. Generation follows testing/quick, which is
+// not imported so that programs using this package do not link the testing
+// packages.
+func randomValue(kind reflect.Type, random *rand.Rand, size int) (value reflect.Value, ok bool) {
+	value = reflect.New(kind).Elem()
+	switch kind.Kind() {
+	case reflect.Bool:
+		value.SetBool(random.Intn(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(int64(random.Uint64()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value.SetUint(random.Uint64())
+	case reflect.Float32:
+		value.SetFloat((2*random.Float64() - 1) * math.MaxFloat32)
+	case reflect.Float64:
+		value.SetFloat((2*random.Float64() - 1) * math.MaxFloat64)
+	case reflect.Complex64:
+		value.SetComplex(complex((2*random.Float64()-1)*math.MaxFloat32, (2*random.Float64()-1)*math.MaxFloat32))
+	case reflect.Complex128:
+		value.SetComplex(complex((2*random.Float64()-1)*math.MaxFloat64, (2*random.Float64()-1)*math.MaxFloat64))
+	case reflect.String:
+		runes := make([]rune, random.Intn(size+1))
+		for index := range runes {
+			runes[index] = rune(random.Intn(utf8.MaxRune + 1))
+		}
+		value.SetString(string(runes))
+	case reflect.Array:
+		return value, randomElements(value, kind.Elem(), random, size)
+	case reflect.Slice:
+		length := random.Intn(size + 1)
+		value.Set(reflect.MakeSlice(kind, length, length))
+		return value, randomElements(value, kind.Elem(), random, size)
+	case reflect.Map:
+		value.Set(reflect.MakeMap(kind))
+		for index := random.Intn(size + 1); index > 0; index-- {
+			key, keyOk := randomValue(kind.Key(), random, size)
+			element, elementOk := randomValue(kind.Elem(), random, size)
+			if !keyOk || !elementOk {
+				return value, false
+			}
+			value.SetMapIndex(key, element)
+		}
+	case reflect.Pointer:
+		if random.Intn(size+1) == 0 {
+			return value, true
+		}
+		element, elementOk := randomValue(kind.Elem(), random, size)
+		if !elementOk {
+			return value, false
+		}
+		value.Set(reflect.New(kind.Elem()))
+		value.Elem().Set(element)
+	case reflect.Struct:
+		for index := 0; index < kind.NumField(); index++ {
+			if !kind.Field(index).IsExported() {
+				return value, false
+			}
+			field, fieldOk := randomValue(kind.Field(index).Type, random, size)
+			if !fieldOk {
+				return value, false
+			}
+			value.Field(index).Set(field)
+		}
+	default:
+		return value, false
+	}
+	return value, true
+}
+
+// randomElements sets each element of the specified array or slice to a random
+// value of the specified type, or returns false if the type cannot be
+// generated.
+func randomElements(value reflect.Value, kind reflect.Type, random *rand.Rand, size int) (ok bool) {
+	for index := 0; index < value.Len(); index++ {
+		element, elementOk := randomValue(kind, random, size)
+		if !elementOk {
+			return false
+		}
+		value.Index(index).Set(element)
+	}
+	return true
+}
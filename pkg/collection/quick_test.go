@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateValues(test *testing.T) {
+	test.Parallel()
+
+	type record struct {
+		Name    string
+		Numbers [2]float32
+		Next    *record
+		Tags    map[uint8]bool
+		Parts   []complex64
+	}
+	random := rand.New(rand.NewSource(1))
+	values := make([]record, 0)
+	for len(values) == 0 {
+		generateValues(random, 4, func(value record) {
+			values = append(values, value)
+		})
+	}
+	require.LessOrEqual(test, len(values), 4)
+	for _, value := range values {
+		require.LessOrEqual(test, len([]rune(value.Name)), 4)
+		require.LessOrEqual(test, len(value.Tags), 4)
+		require.LessOrEqual(test, len(value.Parts), 4)
+	}
+
+	generateValues(random, 4, func(value func()) {
+		require.Fail(test, "unexpected value")
+	})
+	generateValues(random, 4, func(value struct{ name string }) {
+		require.Fail(test, "unexpected value", value)
+	})
+	generateValues(random, 0, func(value int) {
+		require.Fail(test, "unexpected value", value)
+	})
+}
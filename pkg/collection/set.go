@@ -1,30 +1,154 @@
 package collection
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
 )
 
-// Set represents an unordered collection with no duplicate values.
+// Set represents an unordered collection with no duplicate values. The zero
+// value is an empty set that is initialized when a value is first added.
 type Set[Value comparable] map[Value]struct{}
 
+// CollectSet returns a set containing the values produced by the specified
+// sequence. The sequence is compatible with iter.Seq.
+func CollectSet[Value comparable](sequence func(yield func(value Value) (next bool))) (collection Set[Value]) {
+	collection = make(Set[Value])
+	sequence(func(value Value) bool {
+		collection[value] = struct{}{}
+		return true
+	})
+	return collection
+}
+
+// FoldSet combines the values of the specified set, in an arbitrary order, by
+// applying the specified function to the result so far and each value after
+// the first, starting from the first value. Folding stops early if the function
+// returns true for stop. The result and true are returned, or the zero value
+// and false if the set is empty.
+func FoldSet[Value comparable](
+	collection Set[Value], fold func(accumulator Value, value Value) (result Value, stop bool),
+) (result Value, exists bool) {
+	for value := range collection {
+		if !exists {
+			result, exists = value, true
+			continue
+		}
+		var stop bool
+		if result, stop = fold(result, value); stop {
+			break
+		}
+	}
+	return result, exists
+}
+
+// MapSet returns a set containing the results of the specified transform for
+// each value of the specified set.
+func MapSet[Value comparable, Result comparable](
+	collection Set[Value], transform func(value Value) (result Result),
+) (transformed Set[Result]) {
+	transformed = make(Set[Result], len(collection))
+	for value := range collection {
+		transformed[transform(value)] = struct{}{}
+	}
+	return transformed
+}
+
+// NewSet returns an empty set with the specified initial capacity, reusing
+// storage released by ReleaseToPool if available.
+func NewSet[Value comparable](capacity int) (collection Set[Value]) {
+	return newMap[Value, struct{}](capacity)
+}
+
+// ReduceSet combines the values of the specified set, in an arbitrary order, by
+// applying the specified function to the result so far and each value,
+// starting from the specified initial result. Reducing stops early if the
+// function returns true for stop, and the result returned with it is the final
+// result.
+func ReduceSet[Value comparable, Result any](
+	collection Set[Value], initial Result, reduce func(accumulator Result, value Value) (result Result, stop bool),
+) (result Result) {
+	result = initial
+	for value := range collection {
+		var stop bool
+		if result, stop = reduce(result, value); stop {
+			break
+		}
+	}
+	return result
+}
+
+// SetFromSlice returns a set containing the specified values.
+func SetFromSlice[Value comparable](values []Value) (collection Set[Value]) {
+	collection = make(Set[Value], len(values))
+	for _, value := range values {
+		collection[value] = struct{}{}
+	}
+	return collection
+}
+
+// SetOf returns a set containing the specified values.
+func SetOf[Value comparable](values ...Value) (collection Set[Value]) {
+	return SetFromSlice(values)
+}
+
 // Add ensures that the set contains the specified value.
-func (collection Set[Value]) Add(value Value) (modified bool) {
-	_, modified = collection[value]
-	collection[value] = struct{}{}
+func (collection *Set[Value]) Add(value Value) (modified bool) {
+	collection.initialize()
+	_, modified = (*collection)[value]
+	(*collection)[value] = struct{}{}
 	return !modified
 }
 
 // AddAll ensures that the set contains all of the specified values.
-func (collection Set[Value]) AddAll(values ...Value) (modified bool) {
+func (collection *Set[Value]) AddAll(values ...Value) (modified bool) {
+	collection.initialize()
 	for _, value := range values {
-		_, contains := collection[value]
-		collection[value] = struct{}{}
+		_, contains := (*collection)[value]
+		(*collection)[value] = struct{}{}
+		modified = modified || !contains
+	}
+	return modified
+}
+
+// AddFromChannel adds the values received from the specified channel to the set
+// until the channel is closed or the context is done, in which case the
+// context error is returned.
+func (collection *Set[Value]) AddFromChannel(ctx context.Context, channel <-chan Value) (err error) {
+	collection.initialize()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case value, open := <-channel:
+			if !open {
+				return nil
+			}
+			(*collection)[value] = struct{}{}
+		}
+	}
+}
+
+// AddSet ensures that the set contains all of the values of the specified set.
+func (collection *Set[Value]) AddSet(values Set[Value]) (modified bool) {
+	collection.initialize()
+	for value := range values {
+		_, contains := (*collection)[value]
+		(*collection)[value] = struct{}{}
 		modified = modified || !contains
 	}
 	return modified
 }
 
+// AsReadOnly returns a read-only view of the set.
+func (collection *Set[Value]) AsReadOnly() (view UnmodifiableSet[Value]) {
+	return UnmodifiableSet[Value]{collection: collection}
+}
+
 // Clear removes all of the values from the set.
 func (collection *Set[Value]) Clear() (modified bool) {
 	modified = len(*collection) > 0
@@ -32,6 +156,15 @@ func (collection *Set[Value]) Clear() (modified bool) {
 	return modified
 }
 
+// Clone returns a copy of the set.
+func (collection Set[Value]) Clone() (clone Set[Value]) {
+	clone = make(Set[Value], len(collection))
+	for value := range collection {
+		clone[value] = struct{}{}
+	}
+	return clone
+}
+
 // Contains returns true if the set contains the specified value.
 func (collection Set[Value]) Contains(value Value) (contains bool) {
 	_, contains = collection[value]
@@ -48,6 +181,31 @@ func (collection Set[Value]) ContainsAll(values ...Value) (contains bool) {
 	return true
 }
 
+// ContainsAny returns true if the set contains at least one of the specified
+// values.
+func (collection Set[Value]) ContainsAny(values ...Value) (contains bool) {
+	for _, value := range values {
+		if _, contains = collection[value]; contains {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsSet returns true if the set contains all of the values of the
+// specified set.
+func (collection Set[Value]) ContainsSet(values Set[Value]) (contains bool) {
+	if len(values) > len(collection) {
+		return false
+	}
+	for value := range values {
+		if _, contains = collection[value]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
 // Equal compares the set to the specified values for equality.
 func (collection Set[Value]) Equal(values ...Value) (equal bool) {
 	if len(collection) != len(values) {
@@ -68,6 +226,18 @@ func (collection Set[Value]) Equal(values ...Value) (equal bool) {
 	return true
 }
 
+// Filter returns a set containing the values of the set for which the
+// specified predicate returns true.
+func (collection Set[Value]) Filter(predicate func(value Value) (keep bool)) (filtered Set[Value]) {
+	filtered = make(Set[Value])
+	for value := range collection {
+		if predicate(value) {
+			filtered[value] = struct{}{}
+		}
+	}
+	return filtered
+}
+
 // ForEach performs the specified action for each value of the set until all
 // values have been processed or the action returns false.
 func (collection Set[Value]) ForEach(action func(value Value) (next bool)) {
@@ -78,14 +248,127 @@ func (collection Set[Value]) ForEach(action func(value Value) (next bool)) {
 	}
 }
 
+// ForEachCtx performs the specified action for each value of the set until all
+// values have been processed, the action returns false, or the context is
+// done, in which case the context error is returned.
+func (collection Set[Value]) ForEachCtx(ctx context.Context, action func(value Value) (next bool)) (err error) {
+	for value := range collection {
+		if err = ctx.Err(); err != nil {
+			return err
+		} else if !action(value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ForEachErr performs the specified action for each value of the set until all
+// values have been processed or the action returns an error, which is
+// returned.
+func (collection Set[Value]) ForEachErr(action func(value Value) (err error)) (err error) {
+	for value := range collection {
+		if err = action(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachSnapshot performs the specified action for each value of a copy of
+// the set, taken while holding the specified lock, until all values have been
+// processed or the action returns false. The lock is released before the
+// action is called, so the action may modify the set.
+func (collection *Set[Value]) ForEachSnapshot(lock sync.Locker, action func(value Value) (next bool)) {
+	lock.Lock()
+	snapshot := collection.Slice()
+	lock.Unlock()
+	List[Value](snapshot).ForEach(action)
+}
+
+// Format implements fmt.Formatter. The %#v verb prints a Go-syntax
+// representation of the set, and the %+v verb includes struct field names.
+func (collection Set[Value]) Format(state fmt.State, verb rune) {
+	formatCollection(state, verb, collection, map[Value]struct{}(collection), collection.Slice())
+}
+
+// Generate implements quick.Generator, returning a random set of up to the
+// specified number of values.
+func (collection Set[Value]) Generate(random *rand.Rand, size int) (value reflect.Value) {
+	values := make(Set[Value])
+	generateValues(random, size, func(value Value) {
+		values[value] = struct{}{}
+	})
+	return reflect.ValueOf(values)
+}
+
 // IsEmpty returns true if the set contains no values.
 func (collection Set[Value]) IsEmpty() (empty bool) {
 	return len(collection) == 0
 }
 
+// MarshalBinary returns a compact binary representation of the set. Only sets
+// of strings and fixed-size values are supported.
+func (collection Set[Value]) MarshalBinary() (values []byte, err error) {
+	values = appendBinaryHeader(make([]byte, 0), len(collection))
+	for value := range collection {
+		if values, err = appendBinary(values, reflect.ValueOf(&value).Elem()); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
 // MarshalJSON returns a byte representation of the set.
 func (collection Set[Value]) MarshalJSON() (values []byte, err error) {
-	return json.Marshal(collection.Slice())
+	buffer := getSlice[Value](len(collection))
+	defer putSlice(buffer)
+	for value := range collection {
+		*buffer = append(*buffer, value)
+	}
+	return json.Marshal(*buffer)
+}
+
+// MarshalJSONWith returns a byte representation of the set using the specified
+// options.
+func (collection Set[Value]) MarshalJSONWith(options JSONOptions) (values []byte, err error) {
+	if len(collection) == 0 {
+		return options.marshalEmpty("[]"), nil
+	} else if !options.SortSets {
+		return collection.MarshalJSON()
+	}
+	buffer := make([]reflect.Value, 0, len(collection))
+	for value := range collection {
+		current := value
+		buffer = append(buffer, reflect.ValueOf(&current).Elem())
+	}
+	sortValues(buffer)
+	sorted := make([]Value, 0, len(buffer))
+	for _, value := range buffer {
+		current, _ := value.Interface().(Value)
+		sorted = append(sorted, current)
+	}
+	return json.Marshal(sorted)
+}
+
+// ParallelForEach performs the specified action for each value of the set
+// using the specified number of goroutines, until all values have been
+// processed or the action returns false. If the number of goroutines is less
+// than one, GOMAXPROCS goroutines are used. If the action panics, the panic is
+// propagated to the caller.
+func (collection Set[Value]) ParallelForEach(workers int, action func(value Value) (next bool)) {
+	_ = collection.ParallelForEachCtx(context.Background(), workers, action)
+}
+
+// ParallelForEachCtx performs the specified action for each value of the set
+// using the specified number of goroutines, until all values have been
+// processed, the action returns false, or the context is done, in which case
+// the context error is returned. If the number of goroutines is less than one,
+// GOMAXPROCS goroutines are used. If the action panics, the panic is
+// propagated to the caller.
+func (collection Set[Value]) ParallelForEachCtx(
+	ctx context.Context, workers int, action func(value Value) (next bool),
+) (err error) {
+	return parallelForEach(ctx, workers, collection.ForEach, action)
 }
 
 // Partitions performs the specified action for each partition of the specified
@@ -107,6 +390,43 @@ func (collection Set[Value]) Partitions(size int, action func(partition []Value)
 	}
 }
 
+// PartitionsCtx performs the specified action for each partition of the
+// specified size over the values of the set, until all partitions have been
+// processed, the action returns false, or the context is done, in which case
+// the context error is returned. The partition is only valid until the action
+// returns.
+func (collection Set[Value]) PartitionsCtx(
+	ctx context.Context, size int, action func(partition []Value) (next bool),
+) (err error) {
+	collection.Partitions(size, func(partition []Value) bool {
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		return action(partition)
+	})
+	return err
+}
+
+// Pop removes and returns an arbitrary value of the set, or false if the set
+// is empty.
+func (collection Set[Value]) Pop() (value Value, exists bool) {
+	for value = range collection {
+		delete(collection, value)
+		return value, true
+	}
+	return value, false
+}
+
+// ReleaseToPool removes all of the values from the set and releases its storage
+// for reuse by NewSet. The storage must not be used through any other
+// reference to the set after it has been released.
+func (collection *Set[Value]) ReleaseToPool() {
+	if *collection != nil {
+		releaseMap(map[Value]struct{}(*collection))
+	}
+	*collection = nil
+}
+
 // Remove removes the specified value from the set.
 func (collection Set[Value]) Remove(value Value) (modified bool) {
 	_, modified = collection[value]
@@ -124,10 +444,30 @@ func (collection Set[Value]) RemoveAll(values ...Value) (modified bool) {
 	return modified
 }
 
+// RemoveSet removes all of the values of the specified set from the set.
+func (collection Set[Value]) RemoveSet(values Set[Value]) (modified bool) {
+	if len(values) > len(collection) {
+		for value := range collection {
+			if _, contains := values[value]; contains {
+				delete(collection, value)
+				modified = true
+			}
+		}
+		return modified
+	}
+	for value := range values {
+		_, contains := collection[value]
+		delete(collection, value)
+		modified = contains || modified
+	}
+	return modified
+}
+
 // RetainAll removes all values in the set that are not included in the
 // specified values.
 func (collection Set[Value]) RetainAll(values ...Value) (modified bool) {
-	buffer := make(Set[Value], len(values))
+	buffer := getMap[Value, struct{}](len(values))
+	defer putMap(buffer)
 	for _, value := range values {
 		buffer[value] = struct{}{}
 	}
@@ -141,6 +481,18 @@ func (collection Set[Value]) RetainAll(values ...Value) (modified bool) {
 	return modified
 }
 
+// RetainSet removes all values in the set that are not contained in the
+// specified set.
+func (collection Set[Value]) RetainSet(values Set[Value]) (modified bool) {
+	for value := range collection {
+		if _, contains := values[value]; !contains {
+			delete(collection, value)
+			modified = true
+		}
+	}
+	return modified
+}
+
 // Size returns the number of values in the set.
 func (collection Set[Value]) Size() (size int) {
 	return len(collection)
@@ -155,18 +507,115 @@ func (collection Set[Value]) Slice() (values []Value) {
 	return values
 }
 
+// Split divides the values of the set into the specified number of sets whose
+// sizes differ by at most one. If the number of sets is less than one, no sets
+// are returned.
+func (collection Set[Value]) Split(count int) (segments []Set[Value]) {
+	if count <= 0 {
+		return make([]Set[Value], 0)
+	}
+	segments = make([]Set[Value], 0, count)
+	for index := 0; index < count; index++ {
+		segments = append(segments, make(Set[Value], len(collection)/count+1))
+	}
+	index := 0
+	for value := range collection {
+		segments[index][value] = struct{}{}
+		index = (index + 1) % count
+	}
+	return segments
+}
+
 // String returns a string representation of the set.
 func (collection Set[Value]) String() (values string) {
 	return fmt.Sprint(collection.Slice())
 }
 
+// Take removes and returns up to the specified number of arbitrary values of
+// the set.
+func (collection Set[Value]) Take(count int) (values []Value) {
+	if count > len(collection) {
+		count = len(collection)
+	}
+	if count <= 0 {
+		return make([]Value, 0)
+	}
+	values = make([]Value, 0, count)
+	for value := range collection {
+		values = append(values, value)
+		delete(collection, value)
+		if len(values) == count {
+			break
+		}
+	}
+	return values
+}
+
+// ToChannel returns a channel that receives each value of the set. The values
+// are copied when this method is called, and the channel is closed once all
+// values have been sent or the context is done.
+func (collection Set[Value]) ToChannel(ctx context.Context) (channel <-chan Value) {
+	return sendAll(ctx, collection.Slice())
+}
+
+// ToList returns a list containing all of the values in the set.
+func (collection Set[Value]) ToList() (values List[Value]) {
+	return List[Value](collection.Slice())
+}
+
+// UnmarshalBinary replaces all of the set's values with the specified binary
+// representation.
+func (collection *Set[Value]) UnmarshalBinary(values []byte) (err error) {
+	reader := bytes.NewReader(values)
+	size, empty, err := readBinaryHeader(reader, reflect.TypeOf((*Value)(nil)).Elem())
+	if err != nil {
+		return err
+	} else if empty && size > 1 {
+		size = 1
+	}
+	buffer := make(map[Value]struct{}, size)
+	for index := 0; index < size; index++ {
+		var value Value
+		if err = readBinary(reader, reflect.ValueOf(&value).Elem()); err != nil {
+			return err
+		}
+		buffer[value] = struct{}{}
+	}
+	*collection = buffer
+	return nil
+}
+
 // UnmarshalJSON replaces all of the set's values with the specified values.
+// JSON null is treated as an empty set.
 func (collection *Set[Value]) UnmarshalJSON(values []byte) (err error) {
-	buffer := make([]Value, 0)
-	err = json.Unmarshal(values, &buffer)
+	buffer := getSlice[Value](0)
+	err = json.Unmarshal(values, buffer)
 	collection.Clear()
-	for _, value := range buffer {
+	for _, value := range *buffer {
 		(*collection)[value] = struct{}{}
 	}
+	putSlice(buffer)
 	return err
 }
+
+// UnmarshalJSONWith replaces all of the set's values with the specified values
+// using the specified options.
+func (collection *Set[Value]) UnmarshalJSONWith(values []byte, options JSONOptions) (err error) {
+	buffer, err := decodeValues[Value](values, options)
+	if err != nil {
+		return err
+	}
+	elements := SetFromSlice(buffer)
+	if options.DisallowDuplicates && len(elements) != len(buffer) {
+		return ErrDuplicateValue
+	}
+	*collection = elements
+	return nil
+}
+
+// initialize makes the zero value of the set usable for adding values.
+func (collection *Set[Value]) initialize() {
+	if *collection == nil {
+		*collection = make(map[Value]struct{})
+	}
+}
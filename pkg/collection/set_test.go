@@ -1,10 +1,16 @@
 package collection
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math/rand"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +33,72 @@ func ExampleSet() {
 	// Output: [0 1]
 }
 
+func TestCollectSet(test *testing.T) {
+	test.Parallel()
+
+	collection := CollectSet(func(yield func(int) bool) {
+		_ = yield(0) && yield(1) && yield(0)
+	})
+	require.True(test, collection.Equal(0, 1))
+}
+
+func TestFoldSet(test *testing.T) {
+	test.Parallel()
+
+	sum := func(accumulator int, value int) (int, bool) { return accumulator + value, false }
+	result, exists := FoldSet(SetOf(1, 2, 3), sum)
+	require.True(test, exists)
+	require.Equal(test, 6, result)
+	_, exists = FoldSet(SetOf[int](), sum)
+	require.False(test, exists)
+	visited := 1
+	_, _ = FoldSet(SetOf(1, 2, 3), func(accumulator int, value int) (int, bool) {
+		visited++
+		return accumulator, true
+	})
+	require.Equal(test, 2, visited)
+}
+
+func TestMapSet(test *testing.T) {
+	test.Parallel()
+
+	transformed := MapSet(SetOf(-1, 1, 2), func(value int) int { return value * value })
+	require.True(test, transformed.Equal(1, 4))
+}
+
+func TestNewSet(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSet[int](2)
+	require.True(test, collection.IsEmpty())
+	require.True(test, collection.Add(0))
+}
+
+func TestReduceSet(test *testing.T) {
+	test.Parallel()
+
+	count := ReduceSet(SetOf(1, 2, 3, 4), 0, func(accumulator int, value int) (int, bool) {
+		return accumulator + 1, accumulator+1 == 2
+	})
+	require.Equal(test, 2, count)
+	require.Equal(test, 10, ReduceSet(SetOf(1, 2, 3, 4), 0, func(accumulator int, value int) (int, bool) {
+		return accumulator + value, false
+	}))
+}
+
+func TestSetFromSlice(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, SetFromSlice([]int{0, 1, 0}).Equal(0, 1))
+}
+
+func TestSetOf(test *testing.T) {
+	test.Parallel()
+
+	require.True(test, SetOf[int]().IsEmpty())
+	require.True(test, SetOf(0, 1, 0).Equal(0, 1))
+}
+
 func TestSet_Add(test *testing.T) {
 	test.Parallel()
 
@@ -35,6 +107,10 @@ func TestSet_Add(test *testing.T) {
 	require.True(test, collection.Equal(0))
 	require.False(test, collection.Add(0))
 	require.True(test, collection.Equal(0))
+
+	var zero Set[int]
+	require.True(test, zero.Add(0))
+	require.True(test, zero.Equal(0))
 }
 
 func TestSet_AddAll(test *testing.T) {
@@ -45,6 +121,44 @@ func TestSet_AddAll(test *testing.T) {
 	require.True(test, collection.Equal(0, 1))
 	require.False(test, collection.AddAll(0, 1))
 	require.True(test, collection.Equal(0, 1))
+
+	var zero Set[int]
+	require.False(test, zero.AddAll())
+	require.NotNil(test, zero)
+	require.True(test, zero.AddAll(0, 1))
+	require.True(test, zero.Equal(0, 1))
+}
+
+func TestSet_AddFromChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	require.NoError(test, collection.AddFromChannel(context.Background(), SetOf(0, 1).ToChannel(context.Background())))
+	require.True(test, collection.Equal(0, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(test, collection.AddFromChannel(ctx, make(chan int)), context.Canceled)
+}
+
+func TestSet_AddSet(test *testing.T) {
+	test.Parallel()
+
+	var collection Set[int]
+	require.True(test, collection.AddSet(SetOf(0, 1)))
+	require.True(test, collection.Equal(0, 1))
+	require.False(test, collection.AddSet(SetOf(0, 1)))
+	require.False(test, collection.AddSet(nil))
+	require.True(test, collection.Equal(0, 1))
+}
+
+func TestSet_AsReadOnly(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	view := collection.AsReadOnly()
+	require.True(test, collection.Clear())
+	require.True(test, view.IsEmpty())
 }
 
 func TestSet_Clear(test *testing.T) {
@@ -58,6 +172,16 @@ func TestSet_Clear(test *testing.T) {
 	require.False(test, collection.Clear())
 }
 
+func TestSet_Clone(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	clone := collection.Clone()
+	require.True(test, clone.Add(1))
+	require.True(test, collection.Equal(0))
+	require.True(test, clone.Equal(0, 1))
+}
+
 func TestSet_Contains(test *testing.T) {
 	test.Parallel()
 
@@ -76,6 +200,25 @@ func TestSet_ContainsAll(test *testing.T) {
 	require.True(test, collection.ContainsAll(0, 1))
 }
 
+func TestSet_ContainsAny(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf("read", "write")
+	require.True(test, collection.ContainsAny("admin", "write"))
+	require.False(test, collection.ContainsAny("admin"))
+	require.False(test, collection.ContainsAny())
+}
+
+func TestSet_ContainsSet(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1)
+	require.True(test, collection.ContainsSet(nil))
+	require.False(test, collection.ContainsSet(SetOf(0, 2)))
+	require.False(test, collection.ContainsSet(SetOf(0, 1, 2)))
+	require.True(test, collection.ContainsSet(SetOf(0, 1)))
+}
+
 func TestSet_Equal(test *testing.T) {
 	test.Parallel()
 
@@ -88,6 +231,15 @@ func TestSet_Equal(test *testing.T) {
 	require.True(test, collection.Equal(1, 0))
 }
 
+func TestSet_Filter(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2, 3)
+	filtered := collection.Filter(func(value int) bool { return value%2 == 0 })
+	require.True(test, filtered.Equal(0, 2))
+	require.Equal(test, 4, collection.Size())
+}
+
 func TestSet_ForEach(test *testing.T) {
 	test.Parallel()
 
@@ -99,6 +251,82 @@ func TestSet_ForEach(test *testing.T) {
 	})
 }
 
+func TestSet_ForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.ForEachCtx(context.Background(), func(value int) bool {
+		count++
+		return true
+	}))
+	require.Equal(test, 3, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := collection.ForEachCtx(ctx, func(value int) bool {
+		cancel()
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestSet_ForEachErr(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.ForEachErr(func(value int) error {
+		count++
+		return nil
+	}))
+	require.Equal(test, 3, count)
+
+	count = 0
+	err := collection.ForEachErr(func(value int) error {
+		count++
+		return io.EOF
+	})
+	require.ErrorIs(test, err, io.EOF)
+	require.Equal(test, 1, count)
+}
+
+func TestSet_ForEachSnapshot(test *testing.T) {
+	test.Parallel()
+
+	var lock sync.Mutex
+	collection := SetOf(0, 1)
+	count := 0
+	collection.ForEachSnapshot(&lock, func(value int) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		count++
+		collection.Add(value + 2)
+		return true
+	})
+	require.Equal(test, 2, count)
+	require.Equal(test, 4, collection.Size())
+}
+
+func TestSet_Format(test *testing.T) {
+	test.Parallel()
+
+	type element struct{ Name string }
+	collection := Set[element]{{Name: "a"}: {}}
+	require.Equal(test, "[{a}]", fmt.Sprintf("%v", collection))
+	require.Equal(test, "[{Name:a}]", fmt.Sprintf("%+v", collection))
+	require.Equal(test, "collection.Set[int]{0:struct {}{}}", fmt.Sprintf("%#v", Set[int]{0: {}}))
+}
+
+func TestSet_Generate(test *testing.T) {
+	test.Parallel()
+
+	require.NoError(test, quick.Check(func(collection Set[int]) bool {
+		return collection.Equal(collection.Slice()...)
+	}, &quick.Config{MaxCount: 100, MaxCountScale: 0, Rand: nil, Values: nil}))
+	value := Set[int]{}.Generate(rand.New(rand.NewSource(0)), 0)
+	require.Empty(test, value.Interface())
+}
+
 func TestSet_IsEmpty(test *testing.T) {
 	test.Parallel()
 
@@ -108,6 +336,17 @@ func TestSet_IsEmpty(test *testing.T) {
 	require.False(test, collection.IsEmpty())
 }
 
+func TestSet_MarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Set[bool])
+	require.True(test, collection.Add(true))
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+	require.Equal(test, []byte{1, 1, 1}, data)
+}
+
 func TestSet_MarshalJSON(test *testing.T) {
 	test.Parallel()
 
@@ -122,6 +361,51 @@ func TestSet_MarshalJSON(test *testing.T) {
 	require.Equal(test, expected, data)
 }
 
+func TestSet_MarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	var collection Set[int]
+	data, err := collection.MarshalJSONWith(JSONOptions{})
+	require.NoError(test, err)
+	require.Equal(test, "[]", string(data))
+	data, err = collection.MarshalJSONWith(JSONOptions{NullEmpty: true})
+	require.NoError(test, err)
+	require.Equal(test, "null", string(data))
+	data, err = SetOf(2, 10, 1).MarshalJSONWith(JSONOptions{SortSets: true})
+	require.NoError(test, err)
+	require.Equal(test, "[1,2,10]", string(data))
+	data, err = SetOf(0).MarshalJSONWith(JSONOptions{})
+	require.NoError(test, err)
+	require.Equal(test, "[0]", string(data))
+}
+
+func TestSet_ParallelForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2, 3)
+	var sum atomic.Int64
+	collection.ParallelForEach(2, func(value int) bool {
+		sum.Add(int64(value))
+		return true
+	})
+	require.Equal(test, int64(6), sum.Load())
+}
+
+func TestSet_ParallelForEachCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := collection.ParallelForEachCtx(ctx, 2, func(value int) bool {
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+	require.NoError(test, collection.ParallelForEachCtx(context.Background(), 2, func(value int) bool {
+		return true
+	}))
+}
+
 func TestSet_Partitions(test *testing.T) {
 	test.Parallel()
 
@@ -141,6 +425,49 @@ func TestSet_Partitions(test *testing.T) {
 	})
 }
 
+func TestSet_PartitionsCtx(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	count := 0
+	require.NoError(test, collection.PartitionsCtx(context.Background(), 2, func(values []int) bool {
+		count++
+		return true
+	}))
+	require.Equal(test, 2, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := collection.PartitionsCtx(ctx, 1, func(values []int) bool {
+		cancel()
+		return true
+	})
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestSet_Pop(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	value, exists := collection.Pop()
+	require.True(test, exists)
+	require.Equal(test, 0, value)
+	_, exists = collection.Pop()
+	require.False(test, exists)
+	require.True(test, collection.IsEmpty())
+}
+
+func TestSet_ReleaseToPool(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf("a")
+	collection.ReleaseToPool()
+	require.Nil(test, collection)
+	require.True(test, collection.IsEmpty())
+
+	reused := NewSet[string](1)
+	require.True(test, reused.IsEmpty())
+}
+
 func TestSet_Remove(test *testing.T) {
 	test.Parallel()
 
@@ -161,6 +488,17 @@ func TestSet_RemoveAll(test *testing.T) {
 	require.False(test, collection.RemoveAll(0, 1))
 }
 
+func TestSet_RemoveSet(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	require.True(test, collection.RemoveSet(SetOf(0, 1)))
+	require.True(test, collection.Equal(2))
+	require.False(test, collection.RemoveSet(SetOf(0, 1)))
+	require.True(test, collection.RemoveSet(SetOf(0, 1, 2, 3)))
+	require.True(test, collection.IsEmpty())
+}
+
 func TestSet_RetainAll(test *testing.T) {
 	test.Parallel()
 
@@ -171,6 +509,17 @@ func TestSet_RetainAll(test *testing.T) {
 	require.False(test, collection.RetainAll(0, 1))
 }
 
+func TestSet_RetainSet(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	require.True(test, collection.RetainSet(SetOf(0, 1, 3)))
+	require.True(test, collection.Equal(0, 1))
+	require.False(test, collection.RetainSet(SetOf(0, 1)))
+	require.True(test, collection.RetainSet(nil))
+	require.True(test, collection.IsEmpty())
+}
+
 func TestSet_Size(test *testing.T) {
 	test.Parallel()
 
@@ -187,6 +536,22 @@ func TestSet_Slice(test *testing.T) {
 	require.Len(test, collection.Slice(), 1)
 }
 
+func TestSet_Split(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2, 3, 4)
+	segments := collection.Split(3)
+	require.Len(test, segments, 3)
+	union := make(Set[int])
+	for _, segment := range segments {
+		require.GreaterOrEqual(test, segment.Size(), 1)
+		require.LessOrEqual(test, segment.Size(), 2)
+		union.AddAll(segment.Slice()...)
+	}
+	require.True(test, union.Equal(0, 1, 2, 3, 4))
+	require.Empty(test, collection.Split(0))
+}
+
 func TestSet_String(test *testing.T) {
 	test.Parallel()
 
@@ -195,6 +560,56 @@ func TestSet_String(test *testing.T) {
 	require.Equal(test, fmt.Sprint([]int{0}), fmt.Sprint(collection))
 }
 
+func TestSet_Take(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	values := collection.Take(2)
+	require.Len(test, values, 2)
+	require.Equal(test, 1, collection.Size())
+	values = append(values, collection.Take(2)...)
+	require.ElementsMatch(test, []int{0, 1, 2}, values)
+	require.Empty(test, collection.Take(1))
+	require.Empty(test, SetOf(0).Take(0))
+}
+
+func TestSet_ToChannel(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1, 2)
+	values := make([]int, 0)
+	for value := range collection.ToChannel(context.Background()) {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+	require.Equal(test, []int{0, 1, 2}, values)
+}
+
+func TestSet_ToList(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Set[int])
+	require.True(test, collection.ToList().IsEmpty())
+	require.True(test, collection.AddAll(0, 1))
+	require.True(test, collection.ToList().ContainsAll(0, 1))
+	require.Equal(test, 2, collection.ToList().Size())
+}
+
+func TestSet_UnmarshalBinary(test *testing.T) {
+	test.Parallel()
+
+	collection := make(Set[int64])
+	require.True(test, collection.AddAll(-300, 0, 300))
+
+	data, err := collection.MarshalBinary()
+	require.NoError(test, err)
+
+	result := make(Set[int64])
+	require.True(test, result.Add(1))
+	require.NoError(test, result.UnmarshalBinary(data))
+	require.True(test, result.Equal(-300, 0, 300))
+}
+
 func TestSet_UnmarshalJSON(test *testing.T) {
 	test.Parallel()
 
@@ -207,4 +622,26 @@ func TestSet_UnmarshalJSON(test *testing.T) {
 	err = json.Unmarshal(data, &collection)
 	require.NoError(test, err)
 	require.True(test, collection.Equal(0))
+
+	require.NoError(test, json.Unmarshal([]byte("null"), &collection))
+	require.NotNil(test, collection)
+	require.Empty(test, collection)
+}
+
+func TestSet_UnmarshalJSONWith(test *testing.T) {
+	test.Parallel()
+
+	var collection Set[int]
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`[0, 0, 1]`), JSONOptions{}))
+	require.True(test, collection.Equal(0, 1))
+	options := JSONOptions{DisallowDuplicates: true}
+	require.ErrorIs(test, collection.UnmarshalJSONWith([]byte(`[2, 2]`), options), ErrDuplicateValue)
+	require.ErrorIs(test, collection.UnmarshalJSONWith([]byte(`[] []`), options), ErrUnexpectedToken)
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`["a"]`), options))
+	require.Error(test, collection.UnmarshalJSONWith([]byte(`2`), options))
+	require.True(test, collection.Equal(0, 1))
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`2`), JSONOptions{AcceptScalar: true}))
+	require.True(test, collection.Equal(2))
+	require.NoError(test, collection.UnmarshalJSONWith([]byte(`null`), JSONOptions{}))
+	require.True(test, collection.IsEmpty())
 }
@@ -0,0 +1,113 @@
+package collection
+
+import "sort"
+
+// SortedList represents a collection of values ordered by a comparator. Values
+// that are ordered equally are kept in insertion order. The zero value is not
+// usable; use NewSortedList instead.
+type SortedList[Value any] struct {
+	values     List[Value]
+	comparator func(this Value, that Value) (less bool)
+}
+
+// NewSortedList returns an empty list ordered by the specified comparator.
+func NewSortedList[Value any](comparator func(this Value, that Value) (less bool)) (collection *SortedList[Value]) {
+	return &SortedList[Value]{values: make(List[Value], 0), comparator: comparator}
+}
+
+// Add inserts the specified value into the list after any values that are
+// ordered equally.
+func (collection *SortedList[Value]) Add(value Value) {
+	index := collection.upperBound(value)
+	_ = collection.values.Insert(index, value)
+}
+
+// AddAll inserts all of the specified values into the list.
+func (collection *SortedList[Value]) AddAll(values ...Value) {
+	for _, value := range values {
+		collection.Add(value)
+	}
+}
+
+// Clear removes all of the values from the list.
+func (collection *SortedList[Value]) Clear() (modified bool) {
+	return collection.values.Clear()
+}
+
+// Contains returns true if the list contains a value that is ordered equally to
+// the specified value.
+func (collection *SortedList[Value]) Contains(value Value) (contains bool) {
+	return collection.lowerBound(value) < collection.upperBound(value)
+}
+
+// Delete removes the value at the specified position in the list, returning
+// the previous value.
+func (collection *SortedList[Value]) Delete(index int) (previous Value, err error) {
+	return collection.values.Delete(index)
+}
+
+// ForEach performs the specified action for each value of the list, in order,
+// until all values have been processed or the action returns false.
+func (collection *SortedList[Value]) ForEach(action func(value Value) (next bool)) {
+	collection.values.ForEach(action)
+}
+
+// Range performs the specified action for each value of the list that is not
+// ordered before the first value and is ordered before the second value, in
+// order, until all values have been processed or the action returns false.
+func (collection *SortedList[Value]) Range(from Value, to Value, action func(value Value) (next bool)) {
+	end := collection.lowerBound(to)
+	for index := collection.lowerBound(from); index < end; index++ {
+		if !action(collection.values[index]) {
+			return
+		}
+	}
+}
+
+// Rank returns the number of values in the list that are ordered before the
+// specified value.
+func (collection *SortedList[Value]) Rank(value Value) (rank int) {
+	return collection.lowerBound(value)
+}
+
+// Remove removes the first value that is ordered equally to the specified
+// value from the list.
+func (collection *SortedList[Value]) Remove(value Value) (modified bool) {
+	index := collection.lowerBound(value)
+	if index >= collection.upperBound(value) {
+		return false
+	}
+	_, _ = collection.values.Delete(index)
+	return true
+}
+
+// Select returns the value at the specified rank in the list.
+func (collection *SortedList[Value]) Select(rank int) (current Value, err error) {
+	return collection.values.Get(rank)
+}
+
+// Size returns the number of values in the list.
+func (collection *SortedList[Value]) Size() (size int) {
+	return len(collection.values)
+}
+
+// Slice returns a slice containing all of the values in the list, in order.
+func (collection *SortedList[Value]) Slice() (values []Value) {
+	return collection.values.Slice()
+}
+
+// lowerBound returns the position of the first value that is not ordered
+// before the specified value.
+func (collection *SortedList[Value]) lowerBound(value Value) (index int) {
+	return sort.Search(len(collection.values), func(index int) bool {
+		return !collection.comparator(collection.values[index], value)
+	})
+}
+
+// upperBound returns the position of the first value that is ordered after the
+// specified value.
+func (collection *SortedList[Value]) upperBound(value Value) (index int) {
+	return sort.Search(len(collection.values), func(index int) bool {
+		return collection.comparator(value, collection.values[index])
+	})
+}
@@ -0,0 +1,153 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func lessInt(this int, that int) bool {
+	return this < that
+}
+
+func TestNewSortedList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestSortedList_Add(test *testing.T) {
+	test.Parallel()
+
+	type score struct{ name, points int }
+	collection := NewSortedList(func(this score, that score) bool { return this.points > that.points })
+	collection.Add(score{0, 10})
+	collection.Add(score{1, 30})
+	collection.Add(score{2, 10})
+	collection.Add(score{3, 20})
+	require.Equal(test, []score{{1, 30}, {3, 20}, {0, 10}, {2, 10}}, collection.Slice())
+}
+
+func TestSortedList_AddAll(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(3, 1, 2, 1)
+	require.Equal(test, []int{1, 1, 2, 3}, collection.Slice())
+}
+
+func TestSortedList_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	require.False(test, collection.Clear())
+	collection.Add(0)
+	require.True(test, collection.Clear())
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestSortedList_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(0, 2)
+	require.True(test, collection.Contains(2))
+	require.False(test, collection.Contains(1))
+}
+
+func TestSortedList_Delete(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(1, 0)
+	previous, err := collection.Delete(0)
+	require.NoError(test, err)
+	require.Equal(test, 0, previous)
+	_, err = collection.Delete(1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestSortedList_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(2, 0, 1)
+	values := make([]int, 0)
+	collection.ForEach(func(value int) bool {
+		values = append(values, value)
+		return value < 1
+	})
+	require.Equal(test, []int{0, 1}, values)
+}
+
+func TestSortedList_Range(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(5, 1, 3, 2, 4, 3)
+	values := make([]int, 0)
+	collection.Range(2, 4, func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	require.Equal(test, []int{2, 3, 3}, values)
+
+	values = make([]int, 0)
+	collection.Range(0, 10, func(value int) bool {
+		values = append(values, value)
+		return len(values) < 2
+	})
+	require.Equal(test, []int{1, 2}, values)
+}
+
+func TestSortedList_Rank(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(10, 20, 20, 30)
+	require.Equal(test, 0, collection.Rank(5))
+	require.Equal(test, 1, collection.Rank(20))
+	require.Equal(test, 3, collection.Rank(25))
+	require.Equal(test, 4, collection.Rank(35))
+}
+
+func TestSortedList_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(0, 1, 1)
+	require.True(test, collection.Remove(1))
+	require.False(test, collection.Remove(2))
+	require.Equal(test, []int{0, 1}, collection.Slice())
+}
+
+func TestSortedList_Select(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(30, 10, 20)
+	current, err := collection.Select(1)
+	require.NoError(test, err)
+	require.Equal(test, 20, current)
+	_, err = collection.Select(3)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestSortedList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(0, 0)
+	require.Equal(test, 2, collection.Size())
+}
+
+func TestSortedList_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedList(lessInt)
+	collection.AddAll(1, 0)
+	values := collection.Slice()
+	values[0] = 2
+	require.Equal(test, []int{0, 1}, collection.Slice())
+}
@@ -0,0 +1,154 @@
+package collection
+
+import "sort"
+
+// SortedMultiMap represents a collection of keys ordered by a comparator, each
+// associated with one or more values kept in insertion order. Keys that are
+// ordered equally are treated as the same key. The map is not safe for
+// concurrent use. The zero value is not usable; use NewSortedMultiMap instead.
+type SortedMultiMap[Key any, Value any] struct {
+	keys       []Key
+	values     [][]Value
+	size       int
+	comparator func(this Key, that Key) (less bool)
+}
+
+// NewSortedMultiMap returns an empty map ordered by the specified comparator.
+func NewSortedMultiMap[Key any, Value any](
+	comparator func(this Key, that Key) (less bool),
+) (collection *SortedMultiMap[Key, Value]) {
+	return &SortedMultiMap[Key, Value]{keys: nil, values: nil, size: 0, comparator: comparator}
+}
+
+// Clear removes all of the keys and values from the map.
+func (collection *SortedMultiMap[Key, Value]) Clear() (modified bool) {
+	modified = collection.size > 0
+	collection.keys = nil
+	collection.values = nil
+	collection.size = 0
+	return modified
+}
+
+// ContainsKey returns true if the map contains a key that is ordered equally to
+// the specified key.
+func (collection *SortedMultiMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	_, contains = collection.find(key)
+	return contains
+}
+
+// ForEach performs the specified action for each key and value of the map, in
+// key order, until all values have been processed or the action returns false.
+func (collection *SortedMultiMap[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) {
+	collection.forEach(0, len(collection.keys), action)
+}
+
+// Get returns a copy of the values associated with the specified key, in
+// insertion order.
+func (collection *SortedMultiMap[Key, Value]) Get(key Key) (values []Value) {
+	index, exists := collection.find(key)
+	if !exists {
+		return nil
+	}
+	return append(make([]Value, 0, len(collection.values[index])), collection.values[index]...)
+}
+
+// IsEmpty returns true if the map contains no values.
+func (collection *SortedMultiMap[Key, Value]) IsEmpty() (empty bool) {
+	return collection.size == 0
+}
+
+// KeyCount returns the number of distinct keys in the map.
+func (collection *SortedMultiMap[Key, Value]) KeyCount() (count int) {
+	return len(collection.keys)
+}
+
+// Keys returns a slice containing all of the distinct keys in the map, in
+// order.
+func (collection *SortedMultiMap[Key, Value]) Keys() (keys []Key) {
+	return append(make([]Key, 0, len(collection.keys)), collection.keys...)
+}
+
+// Put associates the specified value with the specified key, after any values
+// already associated with it.
+func (collection *SortedMultiMap[Key, Value]) Put(key Key, value Value) {
+	index, exists := collection.find(key)
+	if !exists {
+		var empty Key
+		collection.keys = append(collection.keys, empty)
+		copy(collection.keys[index+1:], collection.keys[index:])
+		collection.keys[index] = key
+		collection.values = append(collection.values, nil)
+		copy(collection.values[index+1:], collection.values[index:])
+		collection.values[index] = nil
+	}
+	collection.values[index] = append(collection.values[index], value)
+	collection.size++
+}
+
+// PutAll associates all of the specified values with the specified key.
+func (collection *SortedMultiMap[Key, Value]) PutAll(key Key, values ...Value) {
+	for _, value := range values {
+		collection.Put(key, value)
+	}
+}
+
+// RangeBetween performs the specified action for each key and value of the map
+// whose key is not ordered before the first key and is ordered before the
+// second key, in key order, until all values have been processed or the action
+// returns false.
+func (collection *SortedMultiMap[Key, Value]) RangeBetween(
+	from Key, to Key, action func(key Key, value Value) (next bool),
+) {
+	start, _ := collection.find(from)
+	end, _ := collection.find(to)
+	collection.forEach(start, end, action)
+}
+
+// Remove removes the specified key and all of its values from the map,
+// returning the previous values.
+func (collection *SortedMultiMap[Key, Value]) Remove(key Key) (previous []Value) {
+	index, exists := collection.find(key)
+	if !exists {
+		return nil
+	}
+	previous = collection.values[index]
+	last := len(collection.keys) - 1
+	copy(collection.keys[index:], collection.keys[index+1:])
+	copy(collection.values[index:], collection.values[index+1:])
+	var empty Key
+	collection.keys[last] = empty
+	collection.values[last] = nil
+	collection.keys = collection.keys[:last]
+	collection.values = collection.values[:last]
+	collection.size -= len(previous)
+	return previous
+}
+
+// Size returns the total number of values in the map.
+func (collection *SortedMultiMap[Key, Value]) Size() (size int) {
+	return collection.size
+}
+
+// find returns the position of the first key that is not ordered before the
+// specified key, and whether that key is ordered equally to it.
+func (collection *SortedMultiMap[Key, Value]) find(key Key) (index int, exists bool) {
+	index = sort.Search(len(collection.keys), func(index int) bool {
+		return !collection.comparator(collection.keys[index], key)
+	})
+	return index, index < len(collection.keys) && !collection.comparator(key, collection.keys[index])
+}
+
+// forEach performs the specified action for each value of the keys between the
+// specified positions until all values have been processed or the action
+// returns false.
+func (collection *SortedMultiMap[Key, Value]) forEach(
+	from int, to int, action func(key Key, value Value) (next bool),
+) {
+	for index := from; index < to; index++ {
+		for _, value := range collection.values[index] {
+			if !action(collection.keys[index], value) {
+				return
+			}
+		}
+	}
+}
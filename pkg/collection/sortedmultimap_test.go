@@ -0,0 +1,129 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSortedMultiMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	require.Equal(test, 0, collection.Size())
+	require.True(test, collection.IsEmpty())
+}
+
+func TestSortedMultiMap_Clear(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	require.False(test, collection.Clear())
+	collection.Put(0, "a")
+	require.True(test, collection.Clear())
+	require.Equal(test, 0, collection.KeyCount())
+}
+
+func TestSortedMultiMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	collection.Put(2, "a")
+	require.True(test, collection.ContainsKey(2))
+	require.False(test, collection.ContainsKey(1))
+	require.False(test, collection.ContainsKey(3))
+}
+
+func TestSortedMultiMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	collection.PutAll(3, "c", "d")
+	collection.Put(1, "a")
+	collection.Put(2, "b")
+	values := make([]string, 0)
+	collection.ForEach(func(key int, value string) bool {
+		values = append(values, value)
+		return len(values) < 3
+	})
+	require.Equal(test, []string{"a", "b", "c"}, values)
+}
+
+func TestSortedMultiMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	collection.PutAll(1, "a", "b")
+	values := collection.Get(1)
+	require.Equal(test, []string{"a", "b"}, values)
+	values[0] = "z"
+	require.Equal(test, []string{"a", "b"}, collection.Get(1))
+	require.Nil(test, collection.Get(2))
+}
+
+func TestSortedMultiMap_Keys(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	collection.Put(3, "c")
+	collection.Put(1, "a")
+	collection.Put(2, "b")
+	collection.Put(1, "d")
+	require.Equal(test, []int{1, 2, 3}, collection.Keys())
+	require.Equal(test, 3, collection.KeyCount())
+	require.Equal(test, 4, collection.Size())
+}
+
+func TestSortedMultiMap_Put(test *testing.T) {
+	test.Parallel()
+
+	type event struct{ time, id int }
+	collection := NewSortedMultiMap[int, event](func(this int, that int) bool { return this > that })
+	collection.Put(10, event{10, 0})
+	collection.Put(30, event{30, 1})
+	collection.Put(10, event{10, 2})
+	entries := make([]event, 0)
+	collection.ForEach(func(key int, value event) bool {
+		entries = append(entries, value)
+		return true
+	})
+	require.Equal(test, []event{{30, 1}, {10, 0}, {10, 2}}, entries)
+}
+
+func TestSortedMultiMap_RangeBetween(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	for key, value := range []string{"a", "b", "c", "d", "e"} {
+		collection.PutAll(key*10, value, value+value)
+	}
+	values := make([]string, 0)
+	collection.RangeBetween(5, 30, func(key int, value string) bool {
+		values = append(values, value)
+		return true
+	})
+	require.Equal(test, []string{"b", "bb", "c", "cc"}, values)
+	values = values[:0]
+	collection.RangeBetween(10, 20, func(key int, value string) bool {
+		values = append(values, value)
+		return false
+	})
+	require.Equal(test, []string{"b"}, values)
+	collection.RangeBetween(30, 10, func(key int, value string) bool {
+		require.Fail(test, "unexpected value", value)
+		return true
+	})
+}
+
+func TestSortedMultiMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSortedMultiMap[int, string](lessInt)
+	collection.PutAll(1, "a", "b")
+	collection.Put(2, "c")
+	collection.Put(3, "d")
+	require.Equal(test, []string{"a", "b"}, collection.Remove(1))
+	require.Nil(test, collection.Remove(1))
+	require.Equal(test, []int{2, 3}, collection.Keys())
+	require.Equal(test, 2, collection.Size())
+}
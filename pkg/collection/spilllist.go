@@ -0,0 +1,367 @@
+package collection
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// spillFanIn is the largest number of sorted runs that are merged at once,
+// which bounds the number of files that are open during a sort.
+const spillFanIn = 64
+
+// spillChunk represents a temporary file holding a number of gob-encoded
+// values.
+type spillChunk struct {
+	path string
+	size int
+}
+
+// spillCursor represents the next value of a sorted run that is being merged,
+// and the position of the run, which keeps the merge stable. Only the chunk
+// being read is open.
+type spillCursor[Value any] struct {
+	chunks    []spillChunk
+	file      *os.File
+	decoder   *gob.Decoder
+	remaining int
+	value     Value
+	order     int
+}
+
+// SpillList represents an ordered collection of values that keeps at most a
+// fixed number of values in memory and spills the rest to temporary files, so
+// datasets larger than memory can be built, iterated, partitioned, and sorted.
+// Values are stored with encoding/gob, so they must be encodable by it, and
+// only exported struct fields are kept. Call Clear once the list is no longer
+// needed to remove its temporary files. The list is not safe for concurrent
+// use. The zero value is not usable; use NewSpillList instead.
+type SpillList[Value any] struct {
+	values    List[Value]
+	chunks    []spillChunk
+	limit     int
+	directory string
+	size      int
+}
+
+// NewSpillList returns an empty list that keeps at most the specified number
+// of values in memory and spills the rest to temporary files in the specified
+// directory. A limit less than one is treated as one, and an empty directory
+// uses the default directory for temporary files.
+func NewSpillList[Value any](limit int, directory string) (collection *SpillList[Value]) {
+	if limit < 1 {
+		limit = 1
+	}
+	return &SpillList[Value]{
+		values:    make(List[Value], 0, limit),
+		chunks:    nil,
+		limit:     limit,
+		directory: directory,
+		size:      0,
+	}
+}
+
+// Add adds the specified value to the end of the list, spilling the values in
+// memory to a temporary file once the limit is reached.
+func (collection *SpillList[Value]) Add(value Value) (err error) {
+	collection.values = append(collection.values, value)
+	collection.size++
+	if len(collection.values) >= collection.limit {
+		return collection.spill()
+	}
+	return nil
+}
+
+// AddAll adds all of the specified values to the end of the list.
+func (collection *SpillList[Value]) AddAll(values ...Value) (err error) {
+	for _, value := range values {
+		if err = collection.Add(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clear removes all of the values from the list and deletes its temporary
+// files.
+func (collection *SpillList[Value]) Clear() (err error) {
+	errs := make([]error, 0)
+	for _, chunk := range collection.chunks {
+		if err = os.Remove(chunk.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	collection.values = make(List[Value], 0, collection.limit)
+	collection.chunks = nil
+	collection.size = 0
+	return errors.Join(errs...)
+}
+
+// ForEach performs the specified action for each value of the list, in order,
+// until all values have been processed or the action returns false. An error
+// is returned if a temporary file could not be read.
+func (collection *SpillList[Value]) ForEach(action func(value Value) (next bool)) (err error) {
+	for _, chunk := range collection.chunks {
+		var next bool
+		if next, err = readSpillChunk(chunk, action); err != nil || !next {
+			return err
+		}
+	}
+	collection.values.ForEach(action)
+	return nil
+}
+
+// Partitions performs the specified action for each partition of the specified
+// size over the values of the list, in order, until all partitions have been
+// processed or the action returns false. At most one partition is held in
+// memory at a time, in addition to the values already in memory.
+func (collection *SpillList[Value]) Partitions(size int, action func(values []Value) (next bool)) (err error) {
+	if size <= 0 {
+		return nil
+	}
+	partition := make([]Value, 0, size)
+	next := true
+	err = collection.ForEach(func(value Value) bool {
+		if partition = append(partition, value); len(partition) == size {
+			next = action(partition)
+			partition = make([]Value, 0, size)
+		}
+		return next
+	})
+	if err == nil && next && len(partition) > 0 {
+		action(partition)
+	}
+	return err
+}
+
+// Size returns the number of values in the list, including spilled values.
+func (collection *SpillList[Value]) Size() (size int) {
+	return collection.size
+}
+
+// Sort sorts the values of the list according to the specified comparator,
+// using an external merge sort that holds at most the limit of values in
+// memory, plus one value per run being merged. Runs are merged in passes of at
+// most 64 at a time, so the number of open files stays bounded. The sort is
+// stable. If an error occurs while sorting, the list is left unchanged.
+func (collection *SpillList[Value]) Sort(comparator func(this Value, that Value) (less bool)) (err error) {
+	scratch := NewSpillList[Value](collection.limit, collection.directory)
+	for _, chunk := range collection.chunks {
+		run := make(List[Value], 0, chunk.size)
+		if _, err = readSpillChunk(chunk, func(value Value) bool {
+			run = append(run, value)
+			return true
+		}); err == nil {
+			err = scratch.addRun(run, comparator)
+		}
+		if err != nil {
+			return errors.Join(err, scratch.Clear())
+		}
+	}
+	if err = scratch.addRun(collection.values, comparator); err != nil {
+		return errors.Join(err, scratch.Clear())
+	}
+	runs := make([][]spillChunk, 0, len(scratch.chunks))
+	for _, chunk := range scratch.chunks {
+		runs = append(runs, []spillChunk{chunk})
+	}
+	for len(runs) > spillFanIn {
+		if runs, err = scratch.mergePass(runs, comparator); err != nil {
+			return errors.Join(err, scratch.Clear())
+		}
+	}
+	sorted := NewSpillList[Value](collection.limit, collection.directory)
+	if err = sorted.mergeRuns(runs, comparator); err != nil {
+		return errors.Join(err, scratch.Clear(), sorted.Clear())
+	}
+	if err = errors.Join(scratch.Clear(), collection.Clear()); err != nil {
+		return errors.Join(err, sorted.Clear())
+	}
+	*collection = *sorted
+	return nil
+}
+
+// addRun stably sorts the specified values and spills them to a temporary file
+// of their own.
+func (collection *SpillList[Value]) addRun(
+	values List[Value], comparator func(this Value, that Value) (less bool),
+) (err error) {
+	if len(values) == 0 {
+		return nil
+	}
+	run := append(make(List[Value], 0, len(values)), values...)
+	sort.SliceStable(run, func(index int, jndex int) bool {
+		return comparator(run[index], run[jndex])
+	})
+	collection.values = run
+	collection.size += len(run)
+	return collection.spill()
+}
+
+// mergePass merges each group of up to 64 consecutive sorted runs into a
+// single run, returning the merged runs and deleting the files of the
+// specified runs. The files of the merged runs are added to the list, so that
+// Clear deletes them.
+func (collection *SpillList[Value]) mergePass(
+	runs [][]spillChunk, comparator func(this Value, that Value) (less bool),
+) (merged [][]spillChunk, err error) {
+	merged = make([][]spillChunk, 0, (len(runs)+spillFanIn-1)/spillFanIn)
+	for start := 0; start < len(runs); start += spillFanIn {
+		end := start + spillFanIn
+		if end > len(runs) {
+			end = len(runs)
+		}
+		output := NewSpillList[Value](collection.limit, collection.directory)
+		err = output.mergeRuns(runs[start:end], comparator)
+		if err == nil && len(output.values) > 0 {
+			err = output.spill()
+		}
+		collection.chunks = append(collection.chunks, output.chunks...)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, output.chunks)
+		for _, run := range runs[start:end] {
+			for _, chunk := range run {
+				if err = os.Remove(chunk.path); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return merged, nil
+}
+
+// mergeRuns adds the values of the specified sorted runs to the list in the
+// order induced by the specified comparator.
+func (collection *SpillList[Value]) mergeRuns(
+	runs [][]spillChunk, comparator func(this Value, that Value) (less bool),
+) (err error) {
+	queue := make(List[*spillCursor[Value]], 0, len(runs))
+	defer func() {
+		for _, cursor := range queue {
+			cursor.close()
+		}
+	}()
+	adapter := AsHeap(&queue, func(this *spillCursor[Value], that *spillCursor[Value]) bool {
+		if comparator(this.value, that.value) {
+			return true
+		}
+		return !comparator(that.value, this.value) && this.order < that.order
+	})
+	for order, run := range runs {
+		var empty Value
+		cursor := &spillCursor[Value]{
+			chunks:    run,
+			file:      nil,
+			decoder:   nil,
+			remaining: 0,
+			value:     empty,
+			order:     order,
+		}
+		var exists bool
+		if exists, err = cursor.advance(); err != nil {
+			cursor.close()
+			return err
+		} else if exists {
+			heap.Push(adapter, cursor)
+		}
+	}
+	for len(queue) > 0 {
+		cursor := queue[0]
+		if err = collection.Add(cursor.value); err != nil {
+			return err
+		}
+		var exists bool
+		if exists, err = cursor.advance(); err != nil {
+			return err
+		} else if exists {
+			heap.Fix(adapter, 0)
+		} else {
+			heap.Pop(adapter)
+		}
+	}
+	return nil
+}
+
+// spill writes the values in memory to a new temporary file and removes them
+// from memory.
+func (collection *SpillList[Value]) spill() (err error) {
+	file, err := os.CreateTemp(collection.directory, "spill-*.gob")
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	encoder := gob.NewEncoder(writer)
+	for index := range collection.values {
+		if err = encoder.Encode(&collection.values[index]); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err = errors.Join(err, file.Close()); err != nil {
+		return errors.Join(err, os.Remove(file.Name()))
+	}
+	collection.chunks = append(collection.chunks, spillChunk{path: file.Name(), size: len(collection.values)})
+	collection.values = make(List[Value], 0, collection.limit)
+	return nil
+}
+
+// advance decodes the next value of the run, opening its next chunk when
+// needed, and returns false once the run is exhausted.
+func (cursor *spillCursor[Value]) advance() (exists bool, err error) {
+	for cursor.remaining == 0 {
+		cursor.close()
+		if len(cursor.chunks) == 0 {
+			return false, nil
+		}
+		if cursor.file, err = os.Open(filepath.Clean(cursor.chunks[0].path)); err != nil {
+			return false, err
+		}
+		cursor.decoder = gob.NewDecoder(bufio.NewReader(cursor.file))
+		cursor.remaining = cursor.chunks[0].size
+		cursor.chunks = cursor.chunks[1:]
+	}
+	var value Value
+	if err = cursor.decoder.Decode(&value); err != nil {
+		return false, err
+	}
+	cursor.value = value
+	cursor.remaining--
+	return true, nil
+}
+
+// close closes the chunk being read, if any.
+func (cursor *spillCursor[Value]) close() {
+	if cursor.file != nil {
+		_ = cursor.file.Close()
+		cursor.file = nil
+	}
+}
+
+// readSpillChunk performs the specified action for each value of the specified
+// chunk, in order, until all values have been processed or the action returns
+// false, in which case false is returned.
+func readSpillChunk[Value any](chunk spillChunk, action func(value Value) (next bool)) (next bool, err error) {
+	file, err := os.Open(filepath.Clean(chunk.path))
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = file.Close() }()
+	decoder := gob.NewDecoder(bufio.NewReader(file))
+	for index := 0; index < chunk.size; index++ {
+		var value Value
+		if err = decoder.Decode(&value); err != nil {
+			return false, err
+		} else if !action(value) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
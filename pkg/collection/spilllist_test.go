@@ -0,0 +1,165 @@
+package collection
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// spillValues returns all of the values of the specified list, in order.
+func spillValues[Value any](test *testing.T, collection *SpillList[Value]) (values []Value) {
+	test.Helper()
+	values = make([]Value, 0, collection.Size())
+	require.NoError(test, collection.ForEach(func(value Value) bool {
+		values = append(values, value)
+		return true
+	}))
+	return values
+}
+
+// spillFiles returns the number of files in the specified directory.
+func spillFiles(test *testing.T, directory string) (count int) {
+	test.Helper()
+	entries, err := os.ReadDir(directory)
+	require.NoError(test, err)
+	return len(entries)
+}
+
+func TestNewSpillList(test *testing.T) {
+	test.Parallel()
+
+	directory := test.TempDir()
+	collection := NewSpillList[int](0, directory)
+	require.NoError(test, collection.Add(1))
+	require.Equal(test, 1, spillFiles(test, directory))
+	require.Equal(test, 1, collection.Size())
+	require.NoError(test, collection.Clear())
+	require.Equal(test, 0, spillFiles(test, directory))
+}
+
+func TestSpillList_Add(test *testing.T) {
+	test.Parallel()
+
+	directory := test.TempDir()
+	collection := NewSpillList[int](3, directory)
+	require.NoError(test, collection.AddAll(0, 1, 2, 3, 4, 5, 6))
+	require.Equal(test, 2, spillFiles(test, directory))
+	require.Equal(test, 7, collection.Size())
+	require.Equal(test, []int{0, 1, 2, 3, 4, 5, 6}, spillValues(test, collection))
+	require.NoError(test, collection.Clear())
+	missing := NewSpillList[int](1, filepath.Join(directory, "missing"))
+	require.Error(test, missing.Add(0))
+	invalid := NewSpillList[func()](1, directory)
+	require.Error(test, invalid.Add(func() {}))
+	require.Equal(test, 0, spillFiles(test, directory))
+}
+
+func TestSpillList_Clear(test *testing.T) {
+	test.Parallel()
+
+	directory := test.TempDir()
+	collection := NewSpillList[string](2, directory)
+	require.NoError(test, collection.AddAll("a", "b", "c", "d", "e"))
+	require.Equal(test, 2, spillFiles(test, directory))
+	require.NoError(test, collection.Clear())
+	require.Equal(test, 0, spillFiles(test, directory))
+	require.Equal(test, 0, collection.Size())
+	require.Empty(test, spillValues(test, collection))
+}
+
+func TestSpillList_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSpillList[int](2, test.TempDir())
+	defer func() { _ = collection.Clear() }()
+	require.NoError(test, collection.AddAll(0, 1, 2, 3, 4))
+	values := make([]int, 0)
+	require.NoError(test, collection.ForEach(func(value int) bool {
+		values = append(values, value)
+		return value < 2
+	}))
+	require.Equal(test, []int{0, 1, 2}, values)
+	require.NoError(test, os.Remove(collection.chunks[0].path))
+	require.Error(test, collection.ForEach(func(value int) bool { return true }))
+}
+
+func TestSpillList_Partitions(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSpillList[int](2, test.TempDir())
+	defer func() { _ = collection.Clear() }()
+	require.NoError(test, collection.AddAll(0, 1, 2, 3, 4, 5, 6))
+	partitions := make([][]int, 0)
+	require.NoError(test, collection.Partitions(3, func(values []int) bool {
+		partitions = append(partitions, values)
+		return true
+	}))
+	require.Equal(test, [][]int{{0, 1, 2}, {3, 4, 5}, {6}}, partitions)
+	partitions = partitions[:0]
+	require.NoError(test, collection.Partitions(3, func(values []int) bool {
+		partitions = append(partitions, values)
+		return false
+	}))
+	require.Equal(test, [][]int{{0, 1, 2}}, partitions)
+	require.NoError(test, collection.Partitions(0, func(values []int) bool {
+		require.Fail(test, "unexpected partition", values)
+		return true
+	}))
+}
+
+func TestSpillList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewSpillList[int](2, test.TempDir())
+	defer func() { _ = collection.Clear() }()
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.AddAll(0, 1, 2))
+	require.Equal(test, 3, collection.Size())
+}
+
+func TestSpillList_Sort(test *testing.T) {
+	test.Parallel()
+
+	type record struct{ Key, Order int }
+	directory := test.TempDir()
+	collection := NewSpillList[record](7, directory)
+	random := rand.New(rand.NewSource(1))
+	expected := make([]record, 0, 100)
+	for index := 0; index < 100; index++ {
+		value := record{Key: random.Intn(10), Order: index}
+		expected = append(expected, value)
+		require.NoError(test, collection.Add(value))
+	}
+	less := func(this record, that record) bool { return this.Key < that.Key }
+	require.NoError(test, collection.Sort(less))
+	sort.SliceStable(expected, func(index int, jndex int) bool { return less(expected[index], expected[jndex]) })
+	require.Equal(test, expected, spillValues(test, collection))
+	require.Equal(test, 100, collection.Size())
+	require.Equal(test, 14, spillFiles(test, directory))
+	require.NoError(test, collection.Clear())
+	require.Equal(test, 0, spillFiles(test, directory))
+	require.NoError(test, collection.Sort(less))
+	require.Equal(test, 0, collection.Size())
+	require.NoError(test, collection.AddAll(expected[:8]...))
+	require.NoError(test, os.Remove(collection.chunks[0].path))
+	require.Error(test, collection.Sort(less))
+	require.Equal(test, 8, collection.Size())
+	require.Equal(test, 0, spillFiles(test, directory))
+	require.NoError(test, collection.Clear())
+	collection = NewSpillList[record](1, directory)
+	expected = expected[:0]
+	for index := 0; index < 2*spillFanIn+1; index++ {
+		value := record{Key: random.Intn(10), Order: index}
+		expected = append(expected, value)
+		require.NoError(test, collection.Add(value))
+	}
+	require.NoError(test, collection.Sort(less))
+	sort.SliceStable(expected, func(index int, jndex int) bool { return less(expected[index], expected[jndex]) })
+	require.Equal(test, expected, spillValues(test, collection))
+	require.Equal(test, 2*spillFanIn+1, spillFiles(test, directory))
+	require.NoError(test, collection.Clear())
+}
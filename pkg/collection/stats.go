@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"math"
+	"sort"
+)
+
+// Summary represents summary statistics of a list of numbers. Variance is the
+// population variance, and Percentiles maps each requested percentile to its
+// value, interpolated linearly between the nearest ranks.
+type Summary[Value Number] struct {
+	Count       int
+	Min         Value
+	Max         Value
+	Mean        float64
+	Variance    float64
+	Percentiles map[float64]float64
+}
+
+// Stats returns summary statistics of the specified list, including the
+// specified percentiles, which range from 0 to 100. The count, minimum,
+// maximum, mean, and variance are computed in a single pass, and percentiles
+// are computed from a sorted copy of the list. Percentiles outside the valid
+// range are clamped to it, and NaN percentiles are ignored, since they could
+// not be looked up.
+func Stats[Value Number](collection List[Value], percentiles ...float64) (summary Summary[Value]) {
+	summary.Percentiles = make(map[float64]float64, len(percentiles))
+	if len(collection) == 0 {
+		return summary
+	}
+	summary.Min, summary.Max = collection[0], collection[0]
+	// Welford's algorithm accumulates the squared distance from the mean.
+	squares := 0.0
+	for index, value := range collection {
+		if value < summary.Min {
+			summary.Min = value
+		} else if value > summary.Max {
+			summary.Max = value
+		}
+		delta := float64(value) - summary.Mean
+		summary.Mean += delta / float64(index+1)
+		squares += delta * (float64(value) - summary.Mean)
+	}
+	summary.Count = len(collection)
+	summary.Variance = squares / float64(len(collection))
+	if len(percentiles) > 0 {
+		sorted := collection.Clone()
+		sort.Slice(sorted, func(index, jndex int) bool {
+			return sorted[index] < sorted[jndex]
+		})
+		for _, percentile := range percentiles {
+			if math.IsNaN(percentile) {
+				continue
+			}
+			rank := math.Max(0, math.Min(100, percentile)) / 100 * float64(len(sorted)-1)
+			lower, upper := int(math.Floor(rank)), int(math.Ceil(rank))
+			fraction := rank - float64(lower)
+			summary.Percentiles[percentile] = float64(sorted[lower]) +
+				fraction*(float64(sorted[upper])-float64(sorted[lower]))
+		}
+	}
+	return summary
+}
@@ -0,0 +1,36 @@
+package collection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats(test *testing.T) {
+	test.Parallel()
+
+	summary := Stats(ListOf(2, 4, 4, 4, 5, 5, 7, 9), 0, 50, 90, 100, 150)
+	require.Equal(test, 8, summary.Count)
+	require.Equal(test, 2, summary.Min)
+	require.Equal(test, 9, summary.Max)
+	require.InDelta(test, 5.0, summary.Mean, 1e-9)
+	require.InDelta(test, 4.0, summary.Variance, 1e-9)
+	require.InDelta(test, 2.0, summary.Percentiles[0], 1e-9)
+	require.InDelta(test, 4.5, summary.Percentiles[50], 1e-9)
+	require.InDelta(test, 7.6, summary.Percentiles[90], 1e-9)
+	require.InDelta(test, 9.0, summary.Percentiles[100], 1e-9)
+	require.InDelta(test, 9.0, summary.Percentiles[150], 1e-9)
+
+	floats := Stats(ListOf(1.5, -0.5))
+	require.Equal(test, -0.5, floats.Min)
+	require.Equal(test, 1.5, floats.Max)
+	require.Empty(test, floats.Percentiles)
+
+	invalid := Stats(ListOf(1, 2, 3), math.NaN(), 50)
+	require.Equal(test, map[float64]float64{50: 2}, invalid.Percentiles)
+
+	empty := Stats(List[uint8]{}, 50)
+	require.Equal(test, 0, empty.Count)
+	require.Empty(test, empty.Percentiles)
+}
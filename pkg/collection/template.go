@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// TemplateFuncs returns functions for use with text/template and
+// html/template that operate on lists, maps, sets, and other slices and maps.
+// The result can be converted to an html/template FuncMap.
+//
+//   - contains returns true if a list contains a value, or if a map or set
+//     contains a key.
+//   - first and last return the first and last values of a list.
+//   - get returns the value of a list at a position, or of a map at a key.
+//   - groupBy groups the values of a list by the value of a field or key.
+//   - join joins the values of a list, or the sorted keys of a map or set.
+//   - keys returns the sorted keys of a map or set.
+func TemplateFuncs() (funcs template.FuncMap) {
+	return template.FuncMap{
+		"contains": templateContains,
+		"first":    templateFirst,
+		"get":      templateGet,
+		"groupBy":  templateGroupBy,
+		"join":     templateJoin,
+		"keys":     templateKeys,
+		"last":     templateLast,
+	}
+}
+
+// templateContains returns true if the specified slice contains the specified
+// value, or if the specified map contains the specified key.
+func templateContains(collection any, value any) (contains bool, err error) {
+	current := reflect.ValueOf(collection)
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < current.Len(); index++ {
+			if reflect.DeepEqual(current.Index(index).Interface(), value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Map:
+		key, err := templateKey(current, value)
+		if err != nil {
+			return false, err
+		}
+		return current.MapIndex(key).IsValid(), nil
+	default:
+		return false, fmt.Errorf("%w: %T", ErrUnsupportedType, collection)
+	}
+}
+
+// templateFirst returns the first value of the specified slice, or nil if the
+// slice is empty.
+func templateFirst(collection any) (value any, err error) {
+	current, err := templateSlice(collection)
+	if err != nil || current.Len() == 0 {
+		return nil, err
+	}
+	return current.Index(0).Interface(), nil
+}
+
+// templateGet returns the value of the specified slice at the specified
+// position, or of the specified map at the specified key.
+func templateGet(collection any, key any) (value any, err error) {
+	current := reflect.ValueOf(collection)
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, ok := key.(int)
+		if !ok || index < 0 || index >= current.Len() {
+			return nil, fmt.Errorf("%w: %v", ErrIndexOutOfRange, key)
+		}
+		return current.Index(index).Interface(), nil
+	case reflect.Map:
+		mapKey, err := templateKey(current, key)
+		if err != nil {
+			return nil, err
+		}
+		if element := current.MapIndex(mapKey); element.IsValid() {
+			return element.Interface(), nil
+		}
+		return reflect.Zero(current.Type().Elem()).Interface(), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, collection)
+	}
+}
+
+// templateGroupBy returns a map that associates each value of the specified
+// field or key with the values of the specified slice that contain it, in
+// order.
+func templateGroupBy(collection any, field string) (groups map[any][]any, err error) {
+	current, err := templateSlice(collection)
+	if err != nil {
+		return nil, err
+	}
+	groups = make(map[any][]any)
+	for index := 0; index < current.Len(); index++ {
+		value := current.Index(index)
+		element := reflect.Indirect(value)
+		var key reflect.Value
+		switch element.Kind() {
+		case reflect.Struct:
+			key = element.FieldByName(field)
+		case reflect.Map:
+			if element.Type().Key().Kind() == reflect.String {
+				key = element.MapIndex(reflect.ValueOf(field).Convert(element.Type().Key()))
+			}
+		default:
+		}
+		if !key.IsValid() || !key.Type().Comparable() {
+			return nil, fmt.Errorf("%w: %v has no comparable field %s", ErrUnsupportedType, value.Type(), field)
+		}
+		groups[key.Interface()] = append(groups[key.Interface()], value.Interface())
+	}
+	return groups, nil
+}
+
+// templateJoin returns the values of the specified slice, or the sorted keys of
+// the specified map, joined by the specified separator.
+func templateJoin(collection any, separator string) (values string, err error) {
+	current := reflect.ValueOf(collection)
+	if current.Kind() == reflect.Map {
+		keys, err := templateKeys(collection)
+		if err != nil {
+			return "", err
+		}
+		current = reflect.ValueOf(keys)
+	} else if current, err = templateSlice(collection); err != nil {
+		return "", err
+	}
+	builder := new(strings.Builder)
+	for index := 0; index < current.Len(); index++ {
+		if index > 0 {
+			builder.WriteString(separator)
+		}
+		fmt.Fprint(builder, current.Index(index).Interface())
+	}
+	return builder.String(), nil
+}
+
+// templateKey converts the specified key to the key type of the specified map.
+// Numbers are converted between numeric types, since template literals are
+// always int or float64.
+func templateKey(collection reflect.Value, key any) (converted reflect.Value, err error) {
+	current := reflect.ValueOf(key)
+	kind := collection.Type().Key()
+	switch {
+	case !current.IsValid():
+		return reflect.Zero(kind), nil
+	case current.Type().AssignableTo(kind):
+		return current, nil
+	case numericKind(current.Kind()) && numericKind(kind.Kind()):
+		return current.Convert(kind), nil
+	default:
+		return converted, fmt.Errorf("%w: %T", ErrUnsupportedType, key)
+	}
+}
+
+// templateKeys returns the keys of the specified map, sorted by value for
+// numbers and strings, and by string representation otherwise.
+func templateKeys(collection any) (keys []any, err error) {
+	current := reflect.ValueOf(collection)
+	if current.Kind() != reflect.Map {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, collection)
+	}
+	values := current.MapKeys()
+	sortValues(values)
+	keys = make([]any, 0, len(values))
+	for _, value := range values {
+		keys = append(keys, value.Interface())
+	}
+	return keys, nil
+}
+
+// templateLast returns the last value of the specified slice, or nil if the
+// slice is empty.
+func templateLast(collection any) (value any, err error) {
+	current, err := templateSlice(collection)
+	if err != nil || current.Len() == 0 {
+		return nil, err
+	}
+	return current.Index(current.Len() - 1).Interface(), nil
+}
+
+// templateSlice returns the reflection value of the specified slice or array.
+func templateSlice(collection any) (current reflect.Value, err error) {
+	current = reflect.ValueOf(collection)
+	if current.Kind() != reflect.Slice && current.Kind() != reflect.Array {
+		return current, fmt.Errorf("%w: %T", ErrUnsupportedType, collection)
+	}
+	return current, nil
+}
@@ -0,0 +1,73 @@
+package collection
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+// executeTemplate returns the output of the specified template executed with
+// the specified data.
+func executeTemplate(text string, data any) (string, error) {
+	parsed, err := template.New("test").Funcs(TemplateFuncs()).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	builder := new(strings.Builder)
+	err = parsed.Execute(builder, data)
+	return builder.String(), err
+}
+
+func TestTemplateFuncs(test *testing.T) {
+	test.Parallel()
+
+	type user struct {
+		Name string
+		Role string
+	}
+	data := map[string]any{
+		"list":  ListOf("a", "b", "c"),
+		"map":   Map[string, int]{"b": 2, "a": 1},
+		"set":   SetOf(3, 1, 2),
+		"users": ListOf(user{Name: "x", Role: "admin"}, user{Name: "y", Role: "guest"}, user{Name: "z", Role: "admin"}),
+		"empty": List[string]{},
+	}
+	for text, expected := range map[string]string{
+		`{{contains .list "b"}} {{contains .list "d"}}`:                     "true false",
+		`{{contains .map "a"}} {{contains .set 4}}`:                         "true false",
+		`{{first .list}} {{last .list}} {{first .empty}}`:                   "a c <no value>",
+		`{{get .list 1}} {{get .map "b"}} {{get .map "c"}}`:                 "b 2 0",
+		`{{join .list ", "}}|{{join .set "-"}}`:                             "a, b, c|1-2-3",
+		`{{range keys .map}}{{.}}{{end}}`:                                   "ab",
+		`{{range (index (groupBy .users "Role") "admin")}}{{.Name}}{{end}}`: "xz",
+	} {
+		output, err := executeTemplate(text, data)
+		require.NoError(test, err, text)
+		require.Equal(test, expected, output, text)
+	}
+	for _, text := range []string{
+		`{{contains 0 0}}`,
+		`{{contains .map 0}}`,
+		`{{first 0}}`,
+		`{{last 0}}`,
+		`{{get 0 0}}`,
+		`{{get .map 0}}`,
+		`{{groupBy .list "Role"}}`,
+		`{{groupBy 0 "Role"}}`,
+		`{{join 0 ""}}`,
+		`{{keys .list}}`,
+	} {
+		_, err := executeTemplate(text, data)
+		require.ErrorIs(test, err, ErrUnsupportedType, text)
+	}
+	_, err := executeTemplate(`{{get .list 3}}`, data)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+	output, err := executeTemplate(`{{get . 1}}`, Map[int64, string]{1: "a"})
+	require.NoError(test, err)
+	require.Equal(test, "a", output)
+	_, err = htmltemplate.New("test").Funcs(htmltemplate.FuncMap(TemplateFuncs())).Parse(`{{keys .}}`)
+	require.NoError(test, err)
+}
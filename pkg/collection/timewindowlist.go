@@ -0,0 +1,121 @@
+package collection
+
+import (
+	"sort"
+	"time"
+)
+
+// timedValue represents a value tagged with the time that it was added.
+type timedValue[Value any] struct {
+	value Value
+	added time.Time
+}
+
+// TimeWindowList represents an ordered collection of values that discards
+// each value once it is older than the window of the list. Values are
+// discarded whenever the list is accessed. The zero value is not usable; use
+// NewTimeWindowList instead.
+type TimeWindowList[Value any] struct {
+	values []timedValue[Value]
+	window time.Duration
+	clock  func() (now time.Time)
+}
+
+// NewTimeWindowList returns an empty list that retains values for the
+// specified window, measured by the specified clock. A nil clock uses
+// time.Now.
+func NewTimeWindowList[Value any](
+	window time.Duration, clock func() (now time.Time),
+) (collection *TimeWindowList[Value]) {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &TimeWindowList[Value]{values: make([]timedValue[Value], 0), window: window, clock: clock}
+}
+
+// Add adds the specified value to the end of the list, tagged with the
+// current time.
+func (collection *TimeWindowList[Value]) Add(value Value) {
+	now := collection.expire()
+	collection.values = append(collection.values, timedValue[Value]{value: value, added: now})
+}
+
+// Clear removes all of the values from the list.
+func (collection *TimeWindowList[Value]) Clear() (modified bool) {
+	collection.expire()
+	modified = len(collection.values) > 0
+	collection.values = make([]timedValue[Value], 0)
+	return modified
+}
+
+// CountWithin returns the number of values added within the specified
+// duration of the current time.
+func (collection *TimeWindowList[Value]) CountWithin(duration time.Duration) (count int) {
+	now := collection.expire()
+	return len(collection.values) - collection.search(now.Add(-duration))
+}
+
+// Size returns the number of values in the list.
+func (collection *TimeWindowList[Value]) Size() (size int) {
+	collection.expire()
+	return len(collection.values)
+}
+
+// Slice returns a slice containing all of the values in the list.
+func (collection *TimeWindowList[Value]) Slice() (values []Value) {
+	collection.expire()
+	return collection.slice(0, len(collection.values))
+}
+
+// SliceWithin returns a slice containing the values added between the
+// specified times, inclusive.
+func (collection *TimeWindowList[Value]) SliceWithin(from time.Time, to time.Time) (values []Value) {
+	collection.expire()
+	start := collection.search(from)
+	end := sort.Search(len(collection.values), func(index int) bool {
+		return collection.values[index].added.After(to)
+	})
+	if end < start {
+		end = start
+	}
+	return collection.slice(start, end)
+}
+
+// Window returns the duration for which values are retained.
+func (collection *TimeWindowList[Value]) Window() (window time.Duration) {
+	return collection.window
+}
+
+// expire discards all of the values older than the window of the list,
+// returning the current time.
+func (collection *TimeWindowList[Value]) expire() (now time.Time) {
+	now = collection.clock()
+	index := collection.search(now.Add(-collection.window))
+	if index > 0 {
+		size := copy(collection.values, collection.values[index:])
+		var empty timedValue[Value]
+		for jndex := size; jndex < len(collection.values); jndex++ {
+			collection.values[jndex] = empty
+		}
+		collection.values = collection.values[:size]
+	}
+	return now
+}
+
+// search returns the position of the first value added at or after the
+// specified time.
+func (collection *TimeWindowList[Value]) search(from time.Time) (index int) {
+	return sort.Search(len(collection.values), func(index int) bool {
+		return !collection.values[index].added.Before(from)
+	})
+}
+
+// slice returns a slice containing the values from the first position,
+// inclusive, to the second position, exclusive.
+func (collection *TimeWindowList[Value]) slice(from int, to int) (values []Value) {
+	values = make([]Value, 0, to-from)
+	for _, element := range collection.values[from:to] {
+		values = append(values, element.value)
+	}
+	return values
+}
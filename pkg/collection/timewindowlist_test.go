@@ -0,0 +1,114 @@
+package collection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock returns a clock that reports the time stored in the specified
+// pointer.
+func fakeClock(now *time.Time) func() time.Time {
+	return func() time.Time { return *now }
+}
+
+func TestNewTimeWindowList(test *testing.T) {
+	test.Parallel()
+
+	collection := NewTimeWindowList[int](time.Minute, nil)
+	collection.Add(0)
+	require.Equal(test, []int{0}, collection.Slice())
+}
+
+func TestTimeWindowList_Add(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	collection.Add(0)
+	now = now.Add(time.Minute)
+	collection.Add(1)
+	require.Equal(test, []int{0, 1}, collection.Slice())
+	now = now.Add(time.Second)
+	require.Equal(test, []int{1}, collection.Slice())
+
+	pointers := NewTimeWindowList[*int](time.Minute, fakeClock(&now))
+	first, second := 0, 1
+	pointers.Add(&first)
+	now = now.Add(time.Minute)
+	pointers.Add(&second)
+	now = now.Add(time.Second)
+	require.Equal(test, []*int{&second}, pointers.Slice())
+	require.Nil(test, pointers.values[:2][1].value)
+}
+
+func TestTimeWindowList_Clear(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	require.False(test, collection.Clear())
+	collection.Add(0)
+	require.True(test, collection.Clear())
+	collection.Add(0)
+	now = now.Add(time.Hour)
+	require.False(test, collection.Clear())
+}
+
+func TestTimeWindowList_CountWithin(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	for index := 0; index < 3; index++ {
+		collection.Add(index)
+		now = now.Add(10 * time.Second)
+	}
+	require.Equal(test, 2, collection.CountWithin(20*time.Second))
+	require.Equal(test, 3, collection.CountWithin(time.Hour))
+	now = now.Add(40 * time.Second)
+	require.Equal(test, 2, collection.CountWithin(time.Hour))
+}
+
+func TestTimeWindowList_Size(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	collection.Add(0)
+	require.Equal(test, 1, collection.Size())
+	now = now.Add(2 * time.Minute)
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestTimeWindowList_Slice(test *testing.T) {
+	test.Parallel()
+
+	now := time.Unix(0, 0)
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	require.Empty(test, collection.Slice())
+	collection.Add(0)
+	collection.Add(1)
+	require.Equal(test, []int{0, 1}, collection.Slice())
+}
+
+func TestTimeWindowList_SliceWithin(test *testing.T) {
+	test.Parallel()
+
+	start := time.Unix(0, 0)
+	now := start
+	collection := NewTimeWindowList[int](time.Minute, fakeClock(&now))
+	for index := 0; index < 4; index++ {
+		collection.Add(index)
+		now = now.Add(10 * time.Second)
+	}
+	require.Equal(test, []int{1, 2}, collection.SliceWithin(start.Add(10*time.Second), start.Add(20*time.Second)))
+	require.Empty(test, collection.SliceWithin(start.Add(time.Hour), start))
+}
+
+func TestTimeWindowList_Window(test *testing.T) {
+	test.Parallel()
+
+	require.Equal(test, time.Minute, NewTimeWindowList[int](time.Minute, nil).Window())
+}
@@ -0,0 +1,89 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCycle indicates that a dependency graph contains a cycle.
+var ErrCycle = errors.New("dependency cycle")
+
+// CycleError indicates that a dependency graph contains a cycle. The path
+// starts and ends with the same node, and each node depends on the next.
+type CycleError struct {
+	Path []any
+}
+
+// Error returns a string representation of the error.
+func (err *CycleError) Error() (message string) {
+	nodes := make([]string, 0, len(err.Path))
+	for _, node := range err.Path {
+		nodes = append(nodes, fmt.Sprint(node))
+	}
+	return fmt.Sprintf("%v: %s", ErrCycle, strings.Join(nodes, " -> "))
+}
+
+// Unwrap returns ErrCycle.
+func (err *CycleError) Unwrap() (cause error) {
+	return ErrCycle
+}
+
+// topoState represents the progress of a depth-first search over a node.
+type topoState int
+
+const (
+	// topoVisiting indicates that the dependencies of a node are being visited.
+	topoVisiting topoState = iota + 1
+	// topoVisited indicates that a node and its dependencies have been sorted.
+	topoVisited
+)
+
+// TopoSort returns the specified nodes, and the nodes that they depend on, in
+// an order where every node follows its dependencies. The order of nodes that
+// do not depend on each other is unspecified. If the dependencies contain a
+// cycle, a *CycleError describing the cycle is returned instead.
+func TopoSort[Node comparable](
+	nodes Set[Node], dependencies func(node Node) (dependencies Set[Node]),
+) (sorted List[Node], err error) {
+	sorted = make(List[Node], 0, len(nodes))
+	states := make(map[Node]topoState, len(nodes))
+	path := make([]Node, 0)
+	var visit func(node Node) (err error)
+	visit = func(node Node) (err error) {
+		switch states[node] {
+		case topoVisited:
+			return nil
+		case topoVisiting:
+			cycle := &CycleError{Path: make([]any, 0)}
+			for index := len(path) - 1; index >= 0; index-- {
+				if path[index] == node {
+					for _, current := range path[index:] {
+						cycle.Path = append(cycle.Path, current)
+					}
+					break
+				}
+			}
+			cycle.Path = append(cycle.Path, node)
+			return cycle
+		default:
+		}
+		states[node] = topoVisiting
+		path = append(path, node)
+		for dependency := range dependencies(node) {
+			if err = visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[node] = topoVisited
+		sorted = append(sorted, node)
+		return nil
+	}
+	for node := range nodes {
+		if err = visit(node); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
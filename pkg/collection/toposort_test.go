@@ -0,0 +1,53 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCycleError_Error(test *testing.T) {
+	test.Parallel()
+
+	err := &CycleError{Path: []any{"a", "b", "a"}}
+	require.Equal(test, "dependency cycle: a -> b -> a", err.Error())
+}
+
+func TestCycleError_Unwrap(test *testing.T) {
+	test.Parallel()
+
+	var err error = &CycleError{Path: []any{"a", "a"}}
+	require.ErrorIs(test, err, ErrCycle)
+}
+
+func TestTopoSort(test *testing.T) {
+	test.Parallel()
+
+	graph := map[string]Set[string]{
+		"app":    SetOf("lib", "log"),
+		"lib":    SetOf("log", "core"),
+		"log":    SetOf("core"),
+		"core":   SetOf[string](),
+		"extern": nil,
+	}
+	dependencies := func(node string) Set[string] { return graph[node] }
+	sorted, err := TopoSort(SetOf("app", "extern"), dependencies)
+	require.NoError(test, err)
+	require.ElementsMatch(test, []string{"app", "lib", "log", "core", "extern"}, sorted)
+	for node, edges := range graph {
+		for edge := range edges {
+			require.Less(test, sorted.IndexOf(edge), sorted.IndexOf(node))
+		}
+	}
+
+	graph["core"] = SetOf("lib")
+	_, err = TopoSort(SetOf("app"), dependencies)
+	var cycle *CycleError
+	require.ErrorAs(test, err, &cycle)
+	require.Equal(test, cycle.Path[0], cycle.Path[len(cycle.Path)-1])
+	require.Contains(test, cycle.Path, "core")
+	require.NotContains(test, cycle.Path, "app")
+
+	_, err = TopoSort(SetOf(0), func(node int) Set[int] { return SetOf(node) })
+	require.Equal(test, &CycleError{Path: []any{0, 0}}, err)
+}
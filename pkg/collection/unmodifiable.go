@@ -0,0 +1,215 @@
+package collection
+
+// UnmodifiableList represents a read-only view of a list. Changes made to the
+// underlying list are visible through the view.
+type UnmodifiableList[Value any] struct {
+	collection *List[Value]
+}
+
+// At returns the value at the specified position in the list. A negative
+// position counts back from the end of the list, so -1 is the last value.
+func (view UnmodifiableList[Value]) At(index int) (current Value, err error) {
+	return view.collection.At(index)
+}
+
+// Contains returns true if the list contains the specified value. This method
+// uses reflection to test equality.
+func (view UnmodifiableList[Value]) Contains(value Value) (contains bool) {
+	return view.collection.Contains(value)
+}
+
+// ContainsAll returns true if the list contains all of the specified values.
+// This method uses reflection to test equality.
+func (view UnmodifiableList[Value]) ContainsAll(values ...Value) (contains bool) {
+	return view.collection.ContainsAll(values...)
+}
+
+// Equal compares the list to the specified values for equality. This method
+// uses reflection to test equality.
+func (view UnmodifiableList[Value]) Equal(values ...Value) (equal bool) {
+	return view.collection.Equal(values...)
+}
+
+// ForEach performs the specified action for each value of the list until all
+// values have been processed or the action returns false.
+func (view UnmodifiableList[Value]) ForEach(action func(value Value) (next bool)) {
+	view.collection.ForEach(action)
+}
+
+// Get returns the value at the specified position in the list.
+func (view UnmodifiableList[Value]) Get(index int) (current Value, err error) {
+	return view.collection.Get(index)
+}
+
+// IndexOf returns the index of the first occurrence of the specified value in
+// the list, or -1 if the list does not contain the specified value. This
+// method uses reflection to test equality.
+func (view UnmodifiableList[Value]) IndexOf(value Value) (index int) {
+	return view.collection.IndexOf(value)
+}
+
+// IsEmpty returns true if the list contains no values.
+func (view UnmodifiableList[Value]) IsEmpty() (empty bool) {
+	return view.collection.IsEmpty()
+}
+
+// LastIndexOf returns the index of the last occurrence of the specified value
+// in the list, or -1 if the list does not contain the specified value. This
+// method uses reflection to test equality.
+func (view UnmodifiableList[Value]) LastIndexOf(value Value) (index int) {
+	return view.collection.LastIndexOf(value)
+}
+
+// MarshalJSON returns a byte representation of the list.
+func (view UnmodifiableList[Value]) MarshalJSON() (values []byte, err error) {
+	return view.collection.MarshalJSON()
+}
+
+// Size returns the number of values in the list.
+func (view UnmodifiableList[Value]) Size() (size int) {
+	return view.collection.Size()
+}
+
+// Slice returns a slice containing all of the values in the list.
+func (view UnmodifiableList[Value]) Slice() (values []Value) {
+	return view.collection.Slice()
+}
+
+// String returns a string representation of the list.
+func (view UnmodifiableList[Value]) String() (values string) {
+	return view.collection.String()
+}
+
+// UnmodifiableMap represents a read-only view of a map. Changes made to the
+// underlying map are visible through the view.
+type UnmodifiableMap[Key comparable, Value any] struct {
+	collection *Map[Key, Value]
+}
+
+// ContainsAll returns true if the map contains all of the specified elements.
+// This method uses reflection to test equality.
+func (view UnmodifiableMap[Key, Value]) ContainsAll(elements map[Key]Value) (contains bool) {
+	return view.collection.ContainsAll(elements)
+}
+
+// ContainsKey returns true if the map contains the specified key.
+func (view UnmodifiableMap[Key, Value]) ContainsKey(key Key) (contains bool) {
+	return view.collection.ContainsKey(key)
+}
+
+// ContainsValue returns true if the map contains the specified value. This
+// method uses reflection to test equality.
+func (view UnmodifiableMap[Key, Value]) ContainsValue(value Value) (contains bool) {
+	return view.collection.ContainsValue(value)
+}
+
+// Equal compares the map to the specified elements for equality. This method
+// uses reflection to test equality.
+func (view UnmodifiableMap[Key, Value]) Equal(elements map[Key]Value) (equal bool) {
+	return view.collection.Equal(elements)
+}
+
+// ForEach performs the specified action for each element of the map until all
+// elements have been processed or the action returns false.
+func (view UnmodifiableMap[Key, Value]) ForEach(action func(key Key, value Value) (next bool)) {
+	view.collection.ForEach(action)
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (view UnmodifiableMap[Key, Value]) Get(key Key) (current Value) {
+	return view.collection.Get(key)
+}
+
+// GetOrDefault returns the value associated with the specified key, or the
+// specified value if the map does not contain the specified key.
+func (view UnmodifiableMap[Key, Value]) GetOrDefault(key Key, value Value) (current Value) {
+	return view.collection.GetOrDefault(key, value)
+}
+
+// IsEmpty returns true if the map contains no elements.
+func (view UnmodifiableMap[Key, Value]) IsEmpty() (empty bool) {
+	return view.collection.IsEmpty()
+}
+
+// Keys returns the keys contained in the map.
+func (view UnmodifiableMap[Key, Value]) Keys() (keys []Key) {
+	return view.collection.Keys()
+}
+
+// Map returns a map containing all of the elements in the map.
+func (view UnmodifiableMap[Key, Value]) Map() (elements map[Key]Value) {
+	return view.collection.Map()
+}
+
+// MarshalJSON returns a byte representation of the map.
+func (view UnmodifiableMap[Key, Value]) MarshalJSON() (elements []byte, err error) {
+	return view.collection.MarshalJSON()
+}
+
+// Size returns the number of elements in the map.
+func (view UnmodifiableMap[Key, Value]) Size() (size int) {
+	return view.collection.Size()
+}
+
+// String returns a string representation of the map.
+func (view UnmodifiableMap[Key, Value]) String() (elements string) {
+	return view.collection.String()
+}
+
+// Values returns the values contained in the map.
+func (view UnmodifiableMap[Key, Value]) Values() (values []Value) {
+	return view.collection.Values()
+}
+
+// UnmodifiableSet represents a read-only view of a set. Changes made to the
+// underlying set are visible through the view.
+type UnmodifiableSet[Value comparable] struct {
+	collection *Set[Value]
+}
+
+// Contains returns true if the set contains the specified value.
+func (view UnmodifiableSet[Value]) Contains(value Value) (contains bool) {
+	return view.collection.Contains(value)
+}
+
+// ContainsAll returns true if the set contains all of the specified values.
+func (view UnmodifiableSet[Value]) ContainsAll(values ...Value) (contains bool) {
+	return view.collection.ContainsAll(values...)
+}
+
+// Equal compares the set to the specified values for equality.
+func (view UnmodifiableSet[Value]) Equal(values ...Value) (equal bool) {
+	return view.collection.Equal(values...)
+}
+
+// ForEach performs the specified action for each value of the set until all
+// values have been processed or the action returns false.
+func (view UnmodifiableSet[Value]) ForEach(action func(value Value) (next bool)) {
+	view.collection.ForEach(action)
+}
+
+// IsEmpty returns true if the set contains no values.
+func (view UnmodifiableSet[Value]) IsEmpty() (empty bool) {
+	return view.collection.IsEmpty()
+}
+
+// MarshalJSON returns a byte representation of the set.
+func (view UnmodifiableSet[Value]) MarshalJSON() (values []byte, err error) {
+	return view.collection.MarshalJSON()
+}
+
+// Size returns the number of values in the set.
+func (view UnmodifiableSet[Value]) Size() (size int) {
+	return view.collection.Size()
+}
+
+// Slice returns a slice containing all of the values in the set.
+func (view UnmodifiableSet[Value]) Slice() (values []Value) {
+	return view.collection.Slice()
+}
+
+// String returns a string representation of the set.
+func (view UnmodifiableSet[Value]) String() (values string) {
+	return view.collection.String()
+}
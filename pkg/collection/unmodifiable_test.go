@@ -0,0 +1,300 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmodifiableList_At(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	value, err := collection.AsReadOnly().At(-1)
+	require.NoError(test, err)
+	require.Equal(test, 1, value)
+}
+
+func TestUnmodifiableList_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0)
+	require.True(test, collection.AsReadOnly().Contains(0))
+	require.False(test, collection.AsReadOnly().Contains(1))
+}
+
+func TestUnmodifiableList_ContainsAll(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	require.True(test, collection.AsReadOnly().ContainsAll(0, 1))
+	require.False(test, collection.AsReadOnly().ContainsAll(0, 2))
+}
+
+func TestUnmodifiableList_Equal(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	require.True(test, collection.AsReadOnly().Equal(0, 1))
+	require.False(test, collection.AsReadOnly().Equal(1, 0))
+}
+
+func TestUnmodifiableList_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	values := make([]int, 0)
+	collection.AsReadOnly().ForEach(func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	require.Equal(test, []int{0, 1}, values)
+}
+
+func TestUnmodifiableList_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0)
+	value, err := collection.AsReadOnly().Get(0)
+	require.NoError(test, err)
+	require.Equal(test, 0, value)
+	_, err = collection.AsReadOnly().Get(1)
+	require.ErrorIs(test, err, ErrIndexOutOfRange)
+}
+
+func TestUnmodifiableList_IndexOf(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 0)
+	require.Equal(test, 0, collection.AsReadOnly().IndexOf(0))
+	require.Equal(test, -1, collection.AsReadOnly().IndexOf(2))
+}
+
+func TestUnmodifiableList_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	var collection List[int]
+	require.True(test, collection.AsReadOnly().IsEmpty())
+}
+
+func TestUnmodifiableList_LastIndexOf(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1, 0)
+	require.Equal(test, 2, collection.AsReadOnly().LastIndexOf(0))
+	require.Equal(test, -1, collection.AsReadOnly().LastIndexOf(2))
+}
+
+func TestUnmodifiableList_MarshalJSON(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	values, err := collection.AsReadOnly().MarshalJSON()
+	require.NoError(test, err)
+	require.Equal(test, "[0,1]", string(values))
+}
+
+func TestUnmodifiableList_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	require.Equal(test, 2, collection.AsReadOnly().Size())
+}
+
+func TestUnmodifiableList_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	require.Equal(test, []int{0, 1}, collection.AsReadOnly().Slice())
+}
+
+func TestUnmodifiableList_String(test *testing.T) {
+	test.Parallel()
+
+	collection := ListOf(0, 1)
+	require.Equal(test, "[0 1]", collection.AsReadOnly().String())
+}
+
+func TestUnmodifiableMap_ContainsAll(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.True(test, collection.AsReadOnly().ContainsAll(map[int]int{0: 1}))
+	require.False(test, collection.AsReadOnly().ContainsAll(map[int]int{0: 0}))
+}
+
+func TestUnmodifiableMap_ContainsKey(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.True(test, collection.AsReadOnly().ContainsKey(0))
+	require.False(test, collection.AsReadOnly().ContainsKey(1))
+}
+
+func TestUnmodifiableMap_ContainsValue(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.True(test, collection.AsReadOnly().ContainsValue(1))
+	require.False(test, collection.AsReadOnly().ContainsValue(0))
+}
+
+func TestUnmodifiableMap_Equal(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.True(test, collection.AsReadOnly().Equal(map[int]int{0: 1}))
+	require.False(test, collection.AsReadOnly().Equal(map[int]int{1: 0}))
+}
+
+func TestUnmodifiableMap_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	elements := make(map[int]int)
+	collection.AsReadOnly().ForEach(func(key int, value int) bool {
+		elements[key] = value
+		return true
+	})
+	require.Equal(test, map[int]int{0: 1}, elements)
+}
+
+func TestUnmodifiableMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, 1, collection.AsReadOnly().Get(0))
+	require.Equal(test, 0, collection.AsReadOnly().Get(1))
+}
+
+func TestUnmodifiableMap_GetOrDefault(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, 1, collection.AsReadOnly().GetOrDefault(0, 2))
+	require.Equal(test, 2, collection.AsReadOnly().GetOrDefault(1, 2))
+}
+
+func TestUnmodifiableMap_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	var collection Map[int, int]
+	require.True(test, collection.AsReadOnly().IsEmpty())
+}
+
+func TestUnmodifiableMap_Keys(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, []int{0}, collection.AsReadOnly().Keys())
+}
+
+func TestUnmodifiableMap_Map(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, map[int]int{0: 1}, collection.AsReadOnly().Map())
+}
+
+func TestUnmodifiableMap_MarshalJSON(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	elements, err := collection.AsReadOnly().MarshalJSON()
+	require.NoError(test, err)
+	require.Equal(test, `{"0":1}`, string(elements))
+}
+
+func TestUnmodifiableMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, 1, collection.AsReadOnly().Size())
+}
+
+func TestUnmodifiableMap_String(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, "map[0:1]", collection.AsReadOnly().String())
+}
+
+func TestUnmodifiableMap_Values(test *testing.T) {
+	test.Parallel()
+
+	collection := MapOf(Entry[int, int]{Key: 0, Value: 1})
+	require.Equal(test, []int{1}, collection.AsReadOnly().Values())
+}
+
+func TestUnmodifiableSet_Contains(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	require.True(test, collection.AsReadOnly().Contains(0))
+	require.False(test, collection.AsReadOnly().Contains(1))
+}
+
+func TestUnmodifiableSet_ContainsAll(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1)
+	require.True(test, collection.AsReadOnly().ContainsAll(0, 1))
+	require.False(test, collection.AsReadOnly().ContainsAll(0, 2))
+}
+
+func TestUnmodifiableSet_Equal(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1)
+	require.True(test, collection.AsReadOnly().Equal(1, 0))
+	require.False(test, collection.AsReadOnly().Equal(0))
+}
+
+func TestUnmodifiableSet_ForEach(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	values := make([]int, 0)
+	collection.AsReadOnly().ForEach(func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	require.Equal(test, []int{0}, values)
+}
+
+func TestUnmodifiableSet_IsEmpty(test *testing.T) {
+	test.Parallel()
+
+	var collection Set[int]
+	require.True(test, collection.AsReadOnly().IsEmpty())
+}
+
+func TestUnmodifiableSet_MarshalJSON(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	values, err := collection.AsReadOnly().MarshalJSON()
+	require.NoError(test, err)
+	require.Equal(test, "[0]", string(values))
+}
+
+func TestUnmodifiableSet_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0, 1)
+	require.Equal(test, 2, collection.AsReadOnly().Size())
+}
+
+func TestUnmodifiableSet_Slice(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	require.Equal(test, []int{0}, collection.AsReadOnly().Slice())
+}
+
+func TestUnmodifiableSet_String(test *testing.T) {
+	test.Parallel()
+
+	collection := SetOf(0)
+	require.Equal(test, "[0]", collection.AsReadOnly().String())
+}
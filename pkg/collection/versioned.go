@@ -0,0 +1,63 @@
+package collection
+
+import "errors"
+
+// ErrNoCheckpoint indicates that no checkpoint was recorded.
+var ErrNoCheckpoint = errors.New("no checkpoint")
+
+// Versioned represents a collection with a history of snapshots that can be
+// restored. The zero value is not usable; use NewVersioned instead.
+type Versioned[Collection interface{ Clone() Collection }] struct {
+	current Collection
+	history []Collection
+}
+
+// NewVersioned returns a versioned wrapper around the specified collection,
+// with no recorded checkpoints.
+func NewVersioned[Collection interface{ Clone() Collection }](current Collection) (collection *Versioned[Collection]) {
+	return &Versioned[Collection]{current: current, history: make([]Collection, 0)}
+}
+
+// Checkpoint records a snapshot of the current collection, returning the
+// number of recorded checkpoints.
+func (collection *Versioned[Collection]) Checkpoint() (size int) {
+	collection.history = append(collection.history, collection.current.Clone())
+	return len(collection.history)
+}
+
+// Current returns a pointer to the current collection, which can be modified
+// in place.
+func (collection *Versioned[Collection]) Current() (current *Collection) {
+	return &collection.current
+}
+
+// Discard removes the most recent checkpoint without restoring it, keeping the
+// changes made since the checkpoint was recorded.
+func (collection *Versioned[Collection]) Discard() (err error) {
+	if len(collection.history) == 0 {
+		return ErrNoCheckpoint
+	}
+	var empty Collection
+	collection.history[len(collection.history)-1] = empty
+	collection.history = collection.history[:len(collection.history)-1]
+	return nil
+}
+
+// History returns copies of the recorded checkpoints, from oldest to newest.
+func (collection *Versioned[Collection]) History() (history []Collection) {
+	history = make([]Collection, 0, len(collection.history))
+	for _, snapshot := range collection.history {
+		history = append(history, snapshot.Clone())
+	}
+	return history
+}
+
+// Rollback replaces the current collection with the most recent checkpoint,
+// and removes the checkpoint from the history.
+func (collection *Versioned[Collection]) Rollback() (err error) {
+	if len(collection.history) == 0 {
+		return ErrNoCheckpoint
+	}
+	collection.current = collection.history[len(collection.history)-1]
+	return collection.Discard()
+}
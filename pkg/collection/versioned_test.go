@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersioned(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(ListOf(0))
+	require.True(test, collection.Current().Equal(0))
+	require.Empty(test, collection.History())
+}
+
+func TestVersioned_Checkpoint(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(ListOf(0))
+	require.Equal(test, 1, collection.Checkpoint())
+	require.True(test, collection.Current().Add(1))
+	require.Equal(test, 2, collection.Checkpoint())
+	require.Equal(test, []List[int]{{0}, {0, 1}}, collection.History())
+}
+
+func TestVersioned_Current(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(SetOf(0))
+	require.True(test, collection.Current().Add(1))
+	require.True(test, collection.Current().Equal(0, 1))
+}
+
+func TestVersioned_Discard(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(Map[int, int]{0: 0})
+	require.ErrorIs(test, collection.Discard(), ErrNoCheckpoint)
+	collection.Checkpoint()
+	collection.Current().Put(1, 1)
+	require.NoError(test, collection.Discard())
+	require.True(test, collection.Current().Equal(map[int]int{0: 0, 1: 1}))
+	require.Empty(test, collection.History())
+}
+
+func TestVersioned_History(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(ListOf(0))
+	collection.Checkpoint()
+	history := collection.History()
+	require.NoError(test, history[0].Set(0, 1))
+	require.Equal(test, []List[int]{{0}}, collection.History())
+}
+
+func TestVersioned_Rollback(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersioned(ListOf(0))
+	require.ErrorIs(test, collection.Rollback(), ErrNoCheckpoint)
+	collection.Checkpoint()
+	require.True(test, collection.Current().Add(1))
+	require.NoError(test, collection.Rollback())
+	require.True(test, collection.Current().Equal(0))
+	require.Empty(test, collection.History())
+}
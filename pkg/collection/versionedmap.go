@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrVersionMismatch indicates that the version of an element was not the
+// expected version.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+// versionedValue represents a value tagged with the version of the write that
+// stored it.
+type versionedValue[Value any] struct {
+	value   Value
+	version uint64
+}
+
+// VersionedMap represents an unordered collection that maps keys to values,
+// where each write assigns the element a new version that is greater than any
+// version previously assigned by the map. Missing keys have version zero.
+// VersionedMap is safe for concurrent use. The zero value is not usable; use
+// NewVersionedMap instead.
+type VersionedMap[Key comparable, Value any] struct {
+	mutex    sync.Mutex
+	elements map[Key]versionedValue[Value]
+	version  uint64
+}
+
+// NewVersionedMap returns an empty versioned map.
+func NewVersionedMap[Key comparable, Value any]() (collection *VersionedMap[Key, Value]) {
+	return &VersionedMap[Key, Value]{elements: make(map[Key]versionedValue[Value])}
+}
+
+// Get returns the value associated with the specified key, or the zero value
+// if the map does not contain the specified key.
+func (collection *VersionedMap[Key, Value]) Get(key Key) (current Value) {
+	current, _ = collection.GetWithVersion(key)
+	return current
+}
+
+// GetWithVersion returns the value and version associated with the specified
+// key, or the zero value and version zero if the map does not contain the
+// specified key.
+func (collection *VersionedMap[Key, Value]) GetWithVersion(key Key) (current Value, version uint64) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	element := collection.elements[key]
+	return element.value, element.version
+}
+
+// Put associates the specified value with the specified key in the map,
+// returning the new version of the element.
+func (collection *VersionedMap[Key, Value]) Put(key Key, value Value) (version uint64) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return collection.put(key, value)
+}
+
+// PutIfVersion associates the specified value with the specified key in the
+// map if the current version of the element is the expected version, returning
+// the new version of the element. An expected version of zero only succeeds if
+// the map does not contain the specified key.
+func (collection *VersionedMap[Key, Value]) PutIfVersion(
+	key Key, value Value, expected uint64,
+) (version uint64, err error) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	if current := collection.elements[key].version; current != expected {
+		return current, fmt.Errorf("%w: expected %d, found %d", ErrVersionMismatch, expected, current)
+	}
+	return collection.put(key, value), nil
+}
+
+// Remove removes the specified key from the map, returning the previous value,
+// if any.
+func (collection *VersionedMap[Key, Value]) Remove(key Key) (previous Value) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	previous = collection.elements[key].value
+	delete(collection.elements, key)
+	return previous
+}
+
+// RemoveIfVersion removes the specified key from the map if the current
+// version of the element is the expected version.
+func (collection *VersionedMap[Key, Value]) RemoveIfVersion(key Key, expected uint64) (err error) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	if current := collection.elements[key].version; current != expected {
+		return fmt.Errorf("%w: expected %d, found %d", ErrVersionMismatch, expected, current)
+	}
+	delete(collection.elements, key)
+	return nil
+}
+
+// Size returns the number of elements in the map.
+func (collection *VersionedMap[Key, Value]) Size() (size int) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return len(collection.elements)
+}
+
+// put associates the specified value with the specified key in the map,
+// returning the new version of the element. The mutex must be held.
+func (collection *VersionedMap[Key, Value]) put(key Key, value Value) (version uint64) {
+	collection.version++
+	collection.elements[key] = versionedValue[Value]{value: value, version: collection.version}
+	return collection.version
+}
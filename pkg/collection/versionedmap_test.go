@@ -0,0 +1,109 @@
+package collection
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionedMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestVersionedMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	collection.Put("a", 1)
+	require.Equal(test, 1, collection.Get("a"))
+	require.Equal(test, 0, collection.Get("b"))
+}
+
+func TestVersionedMap_GetWithVersion(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	version := collection.Put("a", 1)
+	value, current := collection.GetWithVersion("a")
+	require.Equal(test, 1, value)
+	require.Equal(test, version, current)
+	value, current = collection.GetWithVersion("b")
+	require.Equal(test, 0, value)
+	require.Equal(test, uint64(0), current)
+}
+
+func TestVersionedMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	first := collection.Put("a", 1)
+	second := collection.Put("b", 2)
+	third := collection.Put("a", 3)
+	require.Less(test, first, second)
+	require.Less(test, second, third)
+}
+
+func TestVersionedMap_PutIfVersion(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	version, err := collection.PutIfVersion("a", 1, 0)
+	require.NoError(test, err)
+	_, err = collection.PutIfVersion("a", 2, 0)
+	require.ErrorIs(test, err, ErrVersionMismatch)
+	_, err = collection.PutIfVersion("a", 2, version)
+	require.NoError(test, err)
+	require.Equal(test, 2, collection.Get("a"))
+
+	var group sync.WaitGroup
+	counter := NewVersionedMap[string, int]()
+	for index := 0; index < 8; index++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for updated := false; !updated; {
+				value, version := counter.GetWithVersion("count")
+				_, err := counter.PutIfVersion("count", value+1, version)
+				updated = err == nil
+			}
+		}()
+	}
+	group.Wait()
+	require.Equal(test, 8, counter.Get("count"))
+}
+
+func TestVersionedMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	version := collection.Put("a", 1)
+	require.Equal(test, 1, collection.Remove("a"))
+	require.Equal(test, 0, collection.Remove("a"))
+	_, current := collection.GetWithVersion("a")
+	require.Equal(test, uint64(0), current)
+	require.Greater(test, collection.Put("a", 1), version)
+}
+
+func TestVersionedMap_RemoveIfVersion(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	version := collection.Put("a", 1)
+	require.ErrorIs(test, collection.RemoveIfVersion("a", version+1), ErrVersionMismatch)
+	require.NoError(test, collection.RemoveIfVersion("a", version))
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestVersionedMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewVersionedMap[string, int]()
+	collection.Put("a", 1)
+	collection.Put("a", 2)
+	collection.Put("b", 3)
+	require.Equal(test, 2, collection.Size())
+}
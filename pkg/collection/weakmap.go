@@ -0,0 +1,71 @@
+//go:build go1.24
+
+package collection
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// WeakMap represents an unordered collection that maps keys to values without
+// preventing the values from being garbage collected. Once a value has been
+// reclaimed, its key is removed from the map. WeakMap is safe for concurrent
+// use. The zero value is not usable; use NewWeakMap instead.
+//
+// WeakMap requires Go 1.24 or later.
+type WeakMap[Key comparable, Value any] struct {
+	mutex    sync.Mutex
+	elements map[Key]weak.Pointer[Value]
+}
+
+// NewWeakMap returns an empty weak map.
+func NewWeakMap[Key comparable, Value any]() (collection *WeakMap[Key, Value]) {
+	return &WeakMap[Key, Value]{elements: make(map[Key]weak.Pointer[Value])}
+}
+
+// Get returns the value associated with the specified key, or false if the map
+// does not contain the specified key or the value has been reclaimed.
+func (collection *WeakMap[Key, Value]) Get(key Key) (current *Value, contains bool) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	if pointer, exists := collection.elements[key]; exists {
+		current = pointer.Value()
+	}
+	return current, current != nil
+}
+
+// Put associates the specified value with the specified key in the map. A nil
+// value removes the key from the map.
+func (collection *WeakMap[Key, Value]) Put(key Key, value *Value) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	if value == nil {
+		delete(collection.elements, key)
+		return
+	}
+	pointer := weak.Make(value)
+	collection.elements[key] = pointer
+	runtime.AddCleanup(value, func(key Key) {
+		collection.mutex.Lock()
+		defer collection.mutex.Unlock()
+		if collection.elements[key] == pointer {
+			delete(collection.elements, key)
+		}
+	}, key)
+}
+
+// Remove removes the specified key from the map.
+func (collection *WeakMap[Key, Value]) Remove(key Key) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	delete(collection.elements, key)
+}
+
+// Size returns the number of elements in the map, which can include values
+// that have been reclaimed but whose keys have not yet been removed.
+func (collection *WeakMap[Key, Value]) Size() (size int) {
+	collection.mutex.Lock()
+	defer collection.mutex.Unlock()
+	return len(collection.elements)
+}
@@ -0,0 +1,72 @@
+//go:build go1.24
+
+package collection
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWeakMap(test *testing.T) {
+	test.Parallel()
+
+	collection := NewWeakMap[string, int]()
+	require.Equal(test, 0, collection.Size())
+}
+
+func TestWeakMap_Get(test *testing.T) {
+	test.Parallel()
+
+	collection := NewWeakMap[string, [64]byte]()
+	value := new([64]byte)
+	collection.Put("a", value)
+	current, contains := collection.Get("a")
+	require.True(test, contains)
+	require.Same(test, value, current)
+	runtime.KeepAlive(value)
+
+	collection.Put("b", new([64]byte))
+	for attempt := 0; attempt < 10 && collection.Size() > 1; attempt++ {
+		runtime.GC()
+	}
+	_, contains = collection.Get("b")
+	require.False(test, contains)
+	_, contains = collection.Get("c")
+	require.False(test, contains)
+}
+
+func TestWeakMap_Put(test *testing.T) {
+	test.Parallel()
+
+	collection := NewWeakMap[string, int]()
+	value := new(int)
+	collection.Put("a", value)
+	require.Equal(test, 1, collection.Size())
+	collection.Put("a", nil)
+	require.Equal(test, 0, collection.Size())
+	runtime.KeepAlive(value)
+}
+
+func TestWeakMap_Remove(test *testing.T) {
+	test.Parallel()
+
+	collection := NewWeakMap[string, int]()
+	value := new(int)
+	collection.Put("a", value)
+	collection.Remove("a")
+	_, contains := collection.Get("a")
+	require.False(test, contains)
+	runtime.KeepAlive(value)
+}
+
+func TestWeakMap_Size(test *testing.T) {
+	test.Parallel()
+
+	collection := NewWeakMap[string, int]()
+	value := new(int)
+	collection.Put("a", value)
+	require.Equal(test, 1, collection.Size())
+	runtime.KeepAlive(value)
+}